@@ -1,322 +1,85 @@
+// Package api serves an engine.Engine over gRPC. Every call converts its
+// request to the engine's Go types and the result and errors back.
 package api
 
 import (
 	"context"
-	"errors"
-	"sync"
+	"io"
 
+	"github.com/avag-sargsyan/stricache/engine"
 	"github.com/avag-sargsyan/stricache/proto/stricache"
 )
 
-type StringItem struct {
-	Value string
-}
-
-type IntItem struct {
-	Value int64
-}
-
-type FloatItem struct {
-	Value float64
-}
+//go:generate go run gen.go
 
+// Cache serves an engine. It only depends on the Engine interface, so any
+// engine can be served.
 type Cache struct {
-	Strings *stringCache
-	Ints    *intCache
-	Floats  *floatCache
-	mu      sync.RWMutex
-}
-
-type stringCache struct {
-	items map[string]StringItem
-	list  []string
-}
-
-type intCache struct {
-	items map[string]IntItem
-	list  []int64
-}
-
-type floatCache struct {
-	items map[string]FloatItem
-	list  []float64
-}
-
-func NewCacheService() *Cache {
-	cstr := stringCache{
-		map[string]StringItem{},
-		[]string{},
-	}
-	cint := intCache{
-		map[string]IntItem{},
-		[]int64{},
-	}
-	cflt := floatCache{
-		map[string]FloatItem{},
-		[]float64{},
-	}
-	C := &Cache{
-		Strings: &cstr,
-		Ints:    &cint,
-		Floats:  &cflt,
-	}
-	return C
-}
-
-func (c *Cache) AddString(ctx context.Context, item *stricache.StringItem) (*stricache.StringItem, error) {
-	c.mu.Lock()
-	c.Strings.items[item.Key] = StringItem{
-		Value: item.Value,
-	}
-	c.Strings.list = append(c.Strings.list, item.Value)
-	c.mu.Unlock()
-	return item, nil
-}
-
-func (c *Cache) AddInt(ctx context.Context, item *stricache.IntItem) (*stricache.IntItem, error) {
-	c.mu.Lock()
-	c.Ints.items[item.Key] = IntItem{
-		Value: item.Value,
-	}
-	c.Ints.list = append(c.Ints.list, item.Value)
-	c.mu.Unlock()
-	return item, nil
-}
-
-func (c *Cache) AddFloat(ctx context.Context, item *stricache.FloatItem) (*stricache.FloatItem, error) {
-	c.mu.Lock()
-	c.Floats.items[item.Key] = FloatItem{
-		Value: item.Value,
-	}
-	c.Floats.list = append(c.Floats.list, item.Value)
-	c.mu.Unlock()
-	return item, nil
-}
-
-func (c *Cache) UnshiftString(ctx context.Context, item *stricache.StringItem) (*stricache.StringItem, error) {
-	c.mu.Lock()
-	c.Strings.items[item.Key] = StringItem{
-		Value: item.Value,
-	}
-	c.Strings.list = append([]string{item.Value}, c.Strings.list...)
-
-	c.mu.Unlock()
-	return item, nil
-}
-
-func (c *Cache) UnshiftInt(ctx context.Context, item *stricache.IntItem) (*stricache.IntItem, error) {
-	c.mu.Lock()
-	c.Ints.items[item.Key] = IntItem{
-		Value: item.Value,
-	}
-	c.Ints.list = append([]int64{item.Value}, c.Ints.list...)
-	c.mu.Unlock()
-	return item, nil
+	stricache.UnimplementedStricacheServiceServer
+	engine  engine.Engine
+	strings engine.Store[string]
+	ints    engine.Store[int64]
+	floats  engine.Store[float64]
+	bytes   engine.Store[[]byte]
+	limits  Limits
 }
 
-func (c *Cache) UnshiftFloat(ctx context.Context, item *stricache.FloatItem) (*stricache.FloatItem, error) {
-	c.mu.Lock()
-	c.Floats.items[item.Key] = FloatItem{
-		Value: item.Value,
+func NewCacheService(e engine.Engine, opts ...Option) *Cache {
+	c := &Cache{
+		engine:  e,
+		strings: engine.Of(e, engine.String),
+		ints:    engine.Of(e, engine.Int),
+		floats:  engine.Of(e, engine.Float),
+		bytes:   engine.Of(e, engine.Bytes),
 	}
-	c.Floats.list = append([]float64{item.Value}, c.Floats.list...)
-	c.mu.Unlock()
-	return item, nil
-}
-
-func (c *Cache) GetString(ctx context.Context, args *stricache.GetKey) (*stricache.StringItem, error) {
-	key := args.Key
-	c.mu.RLock()
-	value, exists := c.Strings.items[key]
-	if !exists {
-		c.mu.RUnlock()
-		return nil, errors.New("No key found")
+	for _, opt := range opts {
+		opt(c)
 	}
-	c.mu.RUnlock()
-	return &stricache.StringItem{
-		Key:   key,
-		Value: value.Value,
-	}, nil
+	return c
 }
 
-func (c *Cache) GetInt(ctx context.Context, args *stricache.GetKey) (*stricache.IntItem, error) {
-	key := args.Key
-	c.mu.RLock()
-	value, exists := c.Ints.items[key]
-	if !exists {
-		c.mu.RUnlock()
-		return nil, errors.New("No key found")
-	}
-	c.mu.RUnlock()
-	return &stricache.IntItem{
-		Key:   key,
-		Value: value.Value,
-	}, nil
+// Engine returns the engine the service serves.
+func (c *Cache) Engine() engine.Engine {
+	return c.engine
 }
 
-func (c *Cache) GetFloat(ctx context.Context, args *stricache.GetKey) (*stricache.FloatItem, error) {
-	key := args.Key
-	c.mu.RLock()
-	value, exists := c.Floats.items[key]
-	if !exists {
-		c.mu.RUnlock()
-		return nil, errors.New("No key found")
-	}
-	c.mu.RUnlock()
-	return &stricache.FloatItem{
-		Key:   key,
-		Value: value.Value,
-	}, nil
+// Save writes a snapshot of the whole cache to w.
+func (c *Cache) Save(w io.Writer) error {
+	return c.engine.Save(w)
 }
 
-func (c *Cache) DeleteString(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
-	c.mu.Lock()
-	value, exists := c.Strings.items[args.Key]
-	if exists {
-		delete(c.Strings.items, args.Key)
-		for i, v := range c.Strings.list {
-			if value.Value == v {
-				c.Strings.list = append(c.Strings.list[:i], c.Strings.list[i+1:]...)
-				break
-			}
-		}
-	}
-
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
-}
-
-func (c *Cache) DeleteInt(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
-	c.mu.Lock()
-	value, exists := c.Ints.items[args.Key]
-	if exists {
-		delete(c.Ints.items, args.Key)
-		for i, v := range c.Ints.list {
-			if value.Value == v {
-				c.Ints.list = append(c.Ints.list[:i], c.Ints.list[i+1:]...)
-				break
-			}
-		}
-	}
-
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
-}
-
-func (c *Cache) DeleteFloat(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
-	c.mu.Lock()
-	value, exists := c.Floats.items[args.Key]
-	if exists {
-		delete(c.Floats.items, args.Key)
-		for i, v := range c.Floats.list {
-			if value.Value == v {
-				c.Floats.list = append(c.Floats.list[:i], c.Floats.list[i+1:]...)
-				break
-			}
-		}
-	}
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
-}
-
-func (c *Cache) ShiftString(ctx context.Context, e *stricache.EmptyR) (*stricache.Success, error) {
-	var first string
-	c.mu.Lock()
-	first, c.Strings.list = c.Strings.list[0], c.Strings.list[1:len(c.Strings.list)-1]
-	// Sync
-	for i, v := range c.Strings.items {
-		if first == v.Value {
-			delete(c.Strings.items, i)
-		}
-	}
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
-}
-
-func (c *Cache) ShiftInt(ctx context.Context, e *stricache.EmptyR) (*stricache.Success, error) {
-	var first int64
-	c.mu.Lock()
-	first, c.Ints.list = c.Ints.list[0], c.Ints.list[1:len(c.Ints.list)-1]
-	// Sync
-	for i, v := range c.Ints.items {
-		if first == v.Value {
-			delete(c.Ints.items, i)
-		}
-	}
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
-}
-
-func (c *Cache) ShiftFloat(ctx context.Context, e *stricache.EmptyR) (*stricache.Success, error) {
-	var first float64
-	c.mu.Lock()
-	first, c.Floats.list = c.Floats.list[0], c.Floats.list[1:len(c.Floats.list)-1]
-	// Sync
-	for i, v := range c.Floats.items {
-		if first == v.Value {
-			delete(c.Floats.items, i)
-		}
-	}
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
-}
-
-func (c *Cache) PopString(ctx context.Context, e *stricache.EmptyR) (*stricache.Success, error) {
-	var last string
-	c.mu.Lock()
-	c.Strings.list, last = c.Strings.list[:len(c.Strings.list)-1], c.Strings.list[len(c.Strings.list)-1]
-	// Sync
-	for i, v := range c.Strings.items {
-		if last == v.Value {
-			delete(c.Strings.items, i)
-		}
-	}
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
+// Load replaces the contents of the cache with a snapshot read from r.
+func (c *Cache) Load(r io.Reader) error {
+	return c.engine.Load(r)
 }
 
-func (c *Cache) PopInt(ctx context.Context, e *stricache.EmptyR) (*stricache.Success, error) {
-	var last int64
-	c.mu.Lock()
-	c.Ints.list, last = c.Ints.list[:len(c.Ints.list)-1], c.Ints.list[len(c.Ints.list)-1]
-	// Sync
-	for i, v := range c.Ints.items {
-		if last == v.Value {
-			delete(c.Ints.items, i)
-		}
+// Tiers describes the storage tiers of the engine, none unless it is tiered.
+func (c *Cache) Tiers(ctx context.Context, e *stricache.EmptyR) (*stricache.TierInfos, error) {
+	res := &stricache.TierInfos{}
+	for _, t := range c.engine.Tiers() {
+		res.Tiers = append(res.Tiers, &stricache.TierInfo{
+			Name:   t.Name,
+			Keys:   t.Keys,
+			Bytes:  t.Bytes,
+			Budget: t.Budget,
+			Hits:   t.Hits,
+			Misses: t.Misses,
+		})
 	}
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
+	return res, nil
 }
 
-func (c *Cache) PopFloat(ctx context.Context, e *stricache.EmptyR) (*stricache.Success, error) {
-	var last float64
-	c.mu.Lock()
-	c.Floats.list, last = c.Floats.list[:len(c.Floats.list)-1], c.Floats.list[len(c.Floats.list)-1]
-	// Sync
-	for i, v := range c.Floats.items {
-		if last == v.Value {
-			delete(c.Floats.items, i)
-		}
+func (c *Cache) Stats(ctx context.Context, e *stricache.EmptyR) (*stricache.CacheStats, error) {
+	res := &stricache.CacheStats{}
+	for _, u := range c.engine.Usage() {
+		res.Tenants = append(res.Tenants, &stricache.TenantUsage{
+			Tenant:        u.Tenant,
+			Keys:          u.Keys,
+			Bytes:         u.Bytes,
+			MaxKeys:       u.Quota.MaxKeys,
+			MaxBytes:      u.Quota.MaxBytes,
+			MaxListLength: int64(u.Quota.MaxListLength),
+		})
 	}
-	c.mu.Unlock()
-	return &stricache.Success{
-		Success: true,
-	}, nil
+	return res, nil
 }
@@ -1,22 +1,62 @@
 package api_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"os"
+	"runtime"
+	"sync"
 	"testing"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 
-	// api "github.com/avag-sargsyan/stricache/cmd/stricache/api"
+	api "github.com/avag-sargsyan/stricache/cmd/stricache/api"
+	"github.com/avag-sargsyan/stricache/engine"
 	"github.com/avag-sargsyan/stricache/proto/stricache"
 )
 
 var (
 	conn *grpc.ClientConn
 	err  error
+	// addr is the address of the server TestMain starts.
+	addr string
 )
 
+// TestMain serves the API on a free port of 127.0.0.1 for the tests.
+func TestMain(m *testing.M) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	addr = lis.Addr().String()
+	grpcServer := grpc.NewServer()
+	stricache.RegisterStricacheServiceServer(grpcServer, api.NewCacheService(engine.New()))
+	go grpcServer.Serve(lis)
+	code := m.Run()
+	grpcServer.Stop()
+	os.Exit(code)
+}
+
 func TestAPI(t *testing.T) {
-	conn, err = grpc.Dial("127.0.0.1:7999", grpc.WithInsecure())
+	conn, err = grpc.Dial(addr, grpc.WithInsecure())
 	if err != nil {
 		t.Error(err)
 	}
@@ -68,3 +108,1110 @@ func TestAPI(t *testing.T) {
 	}
 	t.Log("Response for getting the key", getKeyRes)
 }
+
+// detail returns the detail of type T of a status error, nil if there is
+// none.
+func detail[T any](err error) T {
+	var none T
+	for _, d := range status.Convert(err).Details() {
+		if d, ok := d.(T); ok {
+			return d
+		}
+	}
+	return none
+}
+
+func reason(err error) string {
+	return detail[*errdetails.ErrorInfo](err).GetReason()
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	// details survive the trip to the client
+	_, err = stricache.NewStricacheServiceClient(conn).GetString(ctx, &stricache.GetKey{Key: "no such key"})
+	if status.Code(err) != codes.NotFound || reason(err) != "KEY_NOT_FOUND" {
+		t.Fatalf("expected NotFound with reason KEY_NOT_FOUND, got %v", err)
+	}
+	if detail[*errdetails.ResourceInfo](err).GetResourceType() != "key" {
+		t.Errorf("expected a ResourceInfo about a key, got %v", status.Convert(err).Details())
+	}
+
+	c := api.NewCacheService(engine.New(engine.WithEviction(engine.Eviction{Policy: engine.EvictionNone, MaxKeys: 1})))
+	if _, err := c.PushString(ctx, &stricache.StringListItem{Value: "v"}); status.Code(err) != codes.InvalidArgument || detail[*errdetails.BadRequest](err) == nil {
+		t.Errorf("expected InvalidArgument with a BadRequest for a missing list name, got %v", err)
+	}
+	if _, err := c.ShiftString(ctx, &stricache.ListPop{}); status.Code(err) != codes.FailedPrecondition || reason(err) != "LIST_EMPTY" || detail[*errdetails.PreconditionFailure](err) == nil {
+		t.Errorf("expected FailedPrecondition with a PreconditionFailure for an empty list, got %v", err)
+	}
+	if _, err := c.ListLenInt(ctx, &stricache.ListKey{List: "none"}); status.Code(err) != codes.NotFound || reason(err) != "LIST_NOT_FOUND" {
+		t.Errorf("expected NotFound with reason LIST_NOT_FOUND, got %v", err)
+	}
+	c.AddString(ctx, &stricache.StringItem{Key: "a"})
+	if _, err := c.AddString(ctx, &stricache.StringItem{Key: "b"}); status.Code(err) != codes.ResourceExhausted || reason(err) != "CACHE_FULL" || detail[*errdetails.QuotaFailure](err) == nil {
+		t.Errorf("expected ResourceExhausted with a QuotaFailure for a full cache, got %v", err)
+	}
+	if res, _ := c.DeleteString(ctx, &stricache.GetKey{Key: "a"}); !res.Success || !res.Removed {
+		t.Errorf("expected Delete of a stored key to report it removed, got %v", res)
+	}
+	if res, _ := c.DeleteString(ctx, &stricache.GetKey{Key: "a"}); !res.Success || res.Removed {
+		t.Errorf("expected Delete of a missing key to report nothing removed, got %v", res)
+	}
+	if res, _ := c.DeleteHash(ctx, &stricache.GetKey{Key: "h"}); res.Removed {
+		t.Errorf("expected DeleteHash of a missing hash to report nothing removed, got %v", res)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	ctx := context.Background()
+	limits := api.Limits{
+		MaxKeyLength: 8,
+		MaxValueSize: 4,
+		KeyCharset:   api.CharsetASCII,
+		NonFinite:    api.FloatsReject,
+	}
+	c := api.NewCacheService(engine.New(engine.WithLimits(engine.Limits{MaxListLength: 1, MaxBytesLen: 4, FiniteScores: true})), api.WithLimits(limits))
+
+	for _, tc := range []struct {
+		name, reason, field string
+		call                func() error
+	}{
+		{"empty key", "KEY_EMPTY", "key", func() error {
+			_, err := c.AddInt(ctx, &stricache.IntItem{})
+			return err
+		}},
+		{"long key", "KEY_TOO_LONG", "key", func() error {
+			_, err := c.AddString(ctx, &stricache.StringItem{Key: "123456789"})
+			return err
+		}},
+		{"space in key", "KEY_CHARSET", "key", func() error {
+			_, err := c.AddString(ctx, &stricache.StringItem{Key: "a b"})
+			return err
+		}},
+		{"long list name", "KEY_TOO_LONG", "list", func() error {
+			_, err := c.PushInt(ctx, &stricache.IntListItem{List: "123456789"})
+			return err
+		}},
+		{"large value", "VALUE_TOO_LARGE", "value", func() error {
+			_, err := c.AddBytes(ctx, &stricache.BytesItem{Key: "b", Value: []byte("12345")})
+			return err
+		}},
+		{"large member", "VALUE_TOO_LARGE", "members", func() error {
+			_, err := c.SAdd(ctx, &stricache.SetMembers{Key: "s", Members: []string{"a", "12345"}})
+			return err
+		}},
+		{"growing value", "VALUE_TOO_LARGE", "value", func() error {
+			_, err := c.SetRange(ctx, &stricache.BytesSetRange{Key: "b", Offset: 4, Value: []byte("1")})
+			return err
+		}},
+		{"far offset", "VALUE_TOO_LARGE", "value", func() error {
+			_, err := c.SetRange(ctx, &stricache.BytesSetRange{Key: "b", Offset: math.MaxInt64, Value: []byte("1")})
+			return err
+		}},
+		{"large appended value", "VALUE_TOO_LARGE", "value", func() error {
+			_, err := c.Append(ctx, &stricache.BytesItem{Key: "b", Value: []byte("12345")})
+			return err
+		}},
+		{"large field name", "VALUE_TOO_LARGE", "fields", func() error {
+			_, err := c.HSet(ctx, &stricache.HashFields{Key: "h", Fields: map[string]string{"12345": "v"}})
+			return err
+		}},
+		{"large incremented field", "VALUE_TOO_LARGE", "field", func() error {
+			_, err := c.HIncrBy(ctx, &stricache.HashIncr{Key: "h", Field: "12345", Delta: 1})
+			return err
+		}},
+		{"NaN", "VALUE_NOT_FINITE", "value", func() error {
+			_, err := c.AddFloat(ctx, &stricache.FloatItem{Key: "f", Value: math.NaN()})
+			return err
+		}},
+		{"infinity", "VALUE_NOT_FINITE", "value", func() error {
+			_, err := c.PushFloat(ctx, &stricache.FloatListItem{List: "l", Value: math.Inf(-1)})
+			return err
+		}},
+		{"infinite score", "VALUE_NOT_FINITE", "items", func() error {
+			_, err := c.ZAdd(ctx, &stricache.SortedSetItems{Key: "z", Items: []*stricache.FloatItem{{Key: "m", Value: math.Inf(1)}}})
+			return err
+		}},
+		{"infinite score increment", "VALUE_NOT_FINITE", "delta", func() error {
+			_, err := c.ZIncrBy(ctx, &stricache.SortedSetIncr{Key: "z", Member: "m", Delta: math.Inf(-1)})
+			return err
+		}},
+		{"NaN number increment", "VALUE_NOT_FINITE", "delta", func() error {
+			_, err := c.JSONNumIncrBy(ctx, &stricache.JSONNumIncr{Key: "j", Path: "$", Delta: math.NaN()})
+			return err
+		}},
+		{"infinite number increment", "VALUE_NOT_FINITE", "delta", func() error {
+			_, err := c.JSONNumIncrBy(ctx, &stricache.JSONNumIncr{Key: "j", Path: "$", Delta: math.Inf(1)})
+			return err
+		}},
+	} {
+		err := tc.call()
+		violations := detail[*errdetails.BadRequest](err).GetFieldViolations()
+		if status.Code(err) != codes.InvalidArgument || reason(err) != tc.reason || len(violations) != 1 || violations[0].Field != tc.field {
+			t.Errorf("%s: expected InvalidArgument with reason %s about %s, got %v", tc.name, tc.reason, tc.field, err)
+		}
+	}
+
+	// the engine rejects increments that overflow to an infinite score
+	if _, err := c.ZAdd(ctx, &stricache.SortedSetItems{Key: "z", Items: []*stricache.FloatItem{{Key: "m", Value: math.MaxFloat64}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ZIncrBy(ctx, &stricache.SortedSetIncr{Key: "z", Member: "m", Delta: math.MaxFloat64}); status.Code(err) != codes.InvalidArgument || reason(err) != "VALUE_NOT_FINITE" {
+		t.Errorf("expected ZIncrBy to an infinite score to fail with VALUE_NOT_FINITE, got %v", err)
+	}
+	if score, _ := c.ZScore(ctx, &stricache.SetMember{Key: "z", Member: "m"}); score.GetValue() != math.MaxFloat64 {
+		t.Errorf("expected the score to stay at MaxFloat64, got %v", score.GetValue())
+	}
+	if _, err := c.AddBytes(ctx, &stricache.BytesItem{Key: "b", Value: []byte("1234")}); err != nil {
+		t.Errorf("expected a value at the limit to be stored, got %v", err)
+	}
+	if _, err := c.Append(ctx, &stricache.BytesItem{Key: "b", Value: []byte("5")}); status.Code(err) != codes.InvalidArgument || reason(err) != "VALUE_TOO_LARGE" {
+		t.Errorf("expected Append past the limit to fail with VALUE_TOO_LARGE, got %v", err)
+	}
+	c.PushInt(ctx, &stricache.IntListItem{List: "l", Value: 1})
+	if _, err := c.PushInt(ctx, &stricache.IntListItem{List: "l", Value: 2}); status.Code(err) != codes.InvalidArgument || reason(err) != "LIST_TOO_LONG" {
+		t.Errorf("expected Push to a full list to fail with LIST_TOO_LONG, got %v", err)
+	}
+
+	info, err := c.Info(ctx, &stricache.EmptyR{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &stricache.Limits{MaxKeyLength: 8, MaxValueSize: 4, KeyCharset: "ascii", NonFinite: "reject", MaxListLength: 1}
+	if !proto.Equal(info.Limits, want) {
+		t.Errorf("Info = %v, want %v", info.Limits, want)
+	}
+}
+
+// TestDocumentSize grows documents by requests within the value size until
+// the stored document would exceed it.
+func TestDocumentSize(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New(engine.WithLimits(engine.Limits{MaxDocumentSize: 32})), api.WithLimits(api.Limits{MaxValueSize: 32}))
+	tooLarge := func(err error) bool {
+		return status.Code(err) == codes.InvalidArgument && reason(err) == "VALUE_TOO_LARGE"
+	}
+
+	if _, err := c.JSONSet(ctx, &stricache.JSONValue{Key: "j", Path: "$", Value: "[]"}); err != nil {
+		t.Fatal(err)
+	}
+	appended := 0
+	for i := 0; i < 100; i++ {
+		_, err := c.JSONArrAppend(ctx, &stricache.JSONValues{Key: "j", Path: "$", Values: []string{`"12345"`}})
+		switch {
+		case err == nil:
+			appended++
+		case !tooLarge(err):
+			t.Fatalf("expected JSONArrAppend past the limit to fail with VALUE_TOO_LARGE, got %v", err)
+		}
+	}
+	got, _ := c.JSONGet(ctx, &stricache.JSONPaths{Key: "j"})
+	if appended != 3 || len(got.Matches[0].Values[0]) > 32 {
+		t.Errorf("expected 3 appends to fit in 32 bytes, got %d making %s", appended, got.Matches[0].Values[0])
+	}
+
+	merged := 0
+	for i := 0; i < 100; i++ {
+		patch, _ := structpb.NewStruct(map[string]interface{}{fmt.Sprint("f", i): "x"})
+		value, _ := anypb.New(patch)
+		_, err := c.SetDocument(ctx, &stricache.DocumentUpdate{Key: "d", Value: value, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{fmt.Sprint("f", i)}}})
+		switch {
+		case err == nil:
+			merged++
+		case !tooLarge(err):
+			t.Fatalf("expected SetDocument past the limit to fail with VALUE_TOO_LARGE, got %v", err)
+		}
+	}
+	doc, err := c.GetDocument(ctx, &stricache.GetKey{Key: "d"})
+	if err != nil || merged == 0 || merged == 100 || len(doc.Value.Value) > 32 {
+		t.Errorf("expected merges to stop at 32 bytes, got %d merges making %d bytes, %v", merged, len(doc.GetValue().GetValue()), err)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	c.AddString(ctx, &stricache.StringItem{Key: "s", Value: "v"})
+	c.AddInt(ctx, &stricache.IntItem{Key: "i", Value: 1})
+	c.HSet(ctx, &stricache.HashFields{Key: "h", Fields: map[string]string{"f": "v"}})
+	c.SAdd(ctx, &stricache.SetMembers{Key: "set", Members: []string{"m"}})
+	c.AddBytes(ctx, &stricache.BytesItem{Key: "b", Value: []byte{0xff, 0}})
+	c.ZAdd(ctx, &stricache.SortedSetItems{Key: "z", Items: []*stricache.FloatItem{{Key: "m", Value: 2.5}}})
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	restored := api.NewCacheService(engine.New())
+	if err := restored.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	s, err := restored.GetString(ctx, &stricache.GetKey{Key: "s"})
+	if err != nil || s.Value != "v" {
+		t.Errorf("unexpected string %v, %v", s, err)
+	}
+	i, err := restored.GetInt(ctx, &stricache.GetKey{Key: "i"})
+	if err != nil || i.Value != 1 {
+		t.Errorf("unexpected int %v, %v", i, err)
+	}
+	h, err := restored.HGet(ctx, &stricache.HashField{Key: "h", Field: "f"})
+	if err != nil || h.Value != "v" {
+		t.Errorf("unexpected hash field %v, %v", h, err)
+	}
+	if m, _ := restored.SIsMember(ctx, &stricache.SetMember{Key: "set", Member: "m"}); !m.Member {
+		t.Error("set member not restored")
+	}
+	if b, err := restored.GetBytes(ctx, &stricache.GetKey{Key: "b"}); err != nil || !bytes.Equal(b.Value, []byte{0xff, 0}) {
+		t.Errorf("unexpected bytes %v, %v", b, err)
+	}
+	if z, err := restored.ZScore(ctx, &stricache.SetMember{Key: "z", Member: "m"}); err != nil || z.Value != 2.5 {
+		t.Errorf("unexpected score %v, %v", z, err)
+	}
+	if _, err := restored.AddFloat(ctx, &stricache.FloatItem{Key: "f", Value: 1.5}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNamedLists(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	for _, v := range []string{"a", "b", "c"} {
+		if _, err := c.PushString(ctx, &stricache.StringListItem{List: "jobs", Value: v}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.PushInt(ctx, &stricache.IntListItem{List: "jobs", Value: 1})
+	c.PushString(ctx, &stricache.StringListItem{List: "other", Value: "x"})
+	if _, err := c.PushString(ctx, &stricache.StringListItem{Value: "x"}); err == nil {
+		t.Error("expected error for a push without list name")
+	}
+
+	lists, _ := c.Lists(ctx, &stricache.EmptyR{})
+	if len(lists.Lists) != 3 {
+		t.Fatalf("expected 3 lists, got %v", lists.Lists)
+	}
+	if l := lists.Lists[0]; l.List != "jobs" || l.Type != stricache.ValueType_STRING || l.Length != 3 {
+		t.Errorf("unexpected list %v", l)
+	}
+
+	first, err := c.ShiftString(ctx, &stricache.ListPop{List: "jobs"})
+	if err != nil || len(first.Items) != 1 || first.Items[0].Value != "a" {
+		t.Errorf("unexpected shift %v, %v", first, err)
+	}
+	last, err := c.PopString(ctx, &stricache.ListPop{List: "jobs"})
+	if err != nil || len(last.Items) != 1 || last.Items[0].Value != "c" {
+		t.Errorf("unexpected pop %v, %v", last, err)
+	}
+	c.DeleteStringList(ctx, &stricache.ListKey{List: "other"})
+	lists, _ = c.Lists(ctx, &stricache.EmptyR{})
+	if len(lists.Lists) != 2 || lists.Lists[0].Length != 1 {
+		t.Errorf("unexpected lists after shift, pop and delete %v", lists.Lists)
+	}
+
+	c.PopString(ctx, &stricache.ListPop{List: "jobs"})
+	if _, err := c.PopString(ctx, &stricache.ListPop{List: "jobs"}); status.Code(err) != codes.NotFound || reason(err) != "LIST_NOT_FOUND" {
+		t.Errorf("expected NotFound LIST_NOT_FOUND for an emptied list, got %v", err)
+	}
+	if _, err := c.ShiftString(ctx, &stricache.ListPop{List: "never"}); status.Code(err) != codes.NotFound || reason(err) != "LIST_NOT_FOUND" {
+		t.Errorf("expected NotFound LIST_NOT_FOUND for a missing list, got %v", err)
+	}
+}
+
+func TestShiftPopUnnamedList(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	if _, err := c.ShiftInt(ctx, &stricache.ListPop{}); status.Code(err) != codes.FailedPrecondition || reason(err) != "LIST_EMPTY" {
+		t.Errorf("expected FailedPrecondition LIST_EMPTY for an empty list, got %v", err)
+	}
+	if _, err := c.PopInt(ctx, &stricache.ListPop{}); status.Code(err) != codes.FailedPrecondition || reason(err) != "LIST_EMPTY" {
+		t.Errorf("expected FailedPrecondition LIST_EMPTY for an empty list, got %v", err)
+	}
+	for i, k := range []string{"a", "b", "c", "d"} {
+		c.AddInt(ctx, &stricache.IntItem{Key: k, Value: int64(i)})
+	}
+
+	popped, err := c.PopInt(ctx, &stricache.ListPop{Count: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(popped.Items) != 2 || popped.Items[0].Key != "d" || popped.Items[1].Value != 2 {
+		t.Errorf("unexpected pop %v", popped.Items)
+	}
+	if _, err := c.GetInt(ctx, &stricache.GetKey{Key: "d"}); err == nil {
+		t.Error("popped key is still stored")
+	}
+
+	shifted, err := c.ShiftInt(ctx, &stricache.ListPop{Count: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shifted.Items) != 2 || shifted.Items[0].Key != "a" || shifted.Items[1].Key != "b" {
+		t.Errorf("unexpected shift %v", shifted.Items)
+	}
+	if _, err := c.ShiftInt(ctx, &stricache.ListPop{Count: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a negative count, got %v", err)
+	}
+}
+
+// waitFor returns once n calls are blocked on list of s.
+func waitFor[T any](t *testing.T, s engine.Store[T], list string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for s.Waiting(list) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d calls wait on list %q, want %d", s.Waiting(list), list, n)
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestBlockingPop(t *testing.T) {
+	ctx := context.Background()
+	e := engine.New()
+	c := api.NewCacheService(e)
+
+	results := []chan string{make(chan string, 1), make(chan string, 1)}
+	for i := range results {
+		go func(result chan string) {
+			res, err := c.BlockingShiftString(ctx, &stricache.BlockingPop{List: "jobs"})
+			if err != nil || len(res.Items) != 1 {
+				result <- fmt.Sprint(res, err)
+				return
+			}
+			result <- res.Items[0].Value
+		}(results[i])
+		// let the waiter queue up before starting the next one
+		waitFor(t, engine.Of(e, engine.String), "jobs", i+1)
+	}
+	c.PushString(ctx, &stricache.StringListItem{List: "jobs", Value: "a"})
+	c.PushString(ctx, &stricache.StringListItem{List: "jobs", Value: "b"})
+	got := []string{<-results[0], <-results[1]}
+	if got[0] != "a" || got[1] != "b" {
+		t.Errorf("waiters not served in order: %v", got)
+	}
+	lists, _ := c.Lists(ctx, &stricache.EmptyR{})
+	if len(lists.Lists) != 0 {
+		t.Errorf("handed off elements should not be stored, got %v", lists.Lists)
+	}
+
+	res, err := c.BlockingPopInt(ctx, &stricache.BlockingPop{TimeoutMs: 10})
+	if err != nil || len(res.Items) != 0 {
+		t.Errorf("expected no items after timeout, got %v, %v", res, err)
+	}
+	// timeouts that don't fit a time.Duration would wrap around to 0, which
+	// waits forever, or to a negative one
+	for _, ms := range []int64{1 << 60, math.MaxInt64, -1 << 62} {
+		if _, err := c.BlockingPopInt(ctx, &stricache.BlockingPop{TimeoutMs: ms}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected a timeout of %dms to fail with InvalidArgument, got %v", ms, err)
+		}
+	}
+
+	floats := engine.Of(e, engine.Float)
+	cctx, cancel := context.WithCancel(ctx)
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := c.BlockingPopFloat(cctx, &stricache.BlockingPop{})
+		cancelled <- err
+	}()
+	waitFor(t, floats, "", 1)
+	cancel()
+	if err := <-cancelled; status.Code(err) != codes.Canceled {
+		t.Errorf("expected Canceled, got %v", err)
+	}
+	if n := floats.Waiting(""); n != 0 {
+		t.Errorf("%d calls still wait after the cancelled one returned", n)
+	}
+
+	fresult := make(chan *stricache.FloatItems, 1)
+	go func() {
+		fres, err := c.BlockingPopFloat(ctx, &stricache.BlockingPop{TimeoutMs: 5000})
+		if err != nil {
+			t.Error(err)
+		}
+		fresult <- fres
+	}()
+	waitFor(t, floats, "", 1)
+	c.AddFloat(ctx, &stricache.FloatItem{Key: "f", Value: 1.5})
+	if fres := <-fresult; fres == nil || len(fres.Items) != 1 || fres.Items[0].Key != "f" {
+		t.Errorf("unexpected blocking pop of the unnamed list %v", fres)
+	}
+}
+
+func TestListOperations(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	for _, v := range []string{"a", "b", "c", "b", "d"} {
+		c.PushString(ctx, &stricache.StringListItem{List: "l", Value: v})
+	}
+	values := func() []string {
+		res, err := c.ListRangeString(ctx, &stricache.ListRange{List: "l", Start: 0, Stop: -1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Values
+	}
+
+	res, _ := c.ListRangeString(ctx, &stricache.ListRange{List: "l", Start: -3, Stop: 100})
+	if fmt.Sprint(res.Values) != "[c b d]" {
+		t.Errorf("unexpected range %v", res.Values)
+	}
+	item, err := c.ListIndexString(ctx, &stricache.ListIndex{List: "l", Index: -1})
+	if err != nil || item.Value != "d" {
+		t.Errorf("unexpected index %v, %v", item, err)
+	}
+	if _, err := c.ListIndexString(ctx, &stricache.ListIndex{List: "l", Index: 5}); status.Code(err) != codes.OutOfRange {
+		t.Errorf("expected OutOfRange, got %v", err)
+	}
+
+	c.ListSetString(ctx, &stricache.StringListSet{List: "l", Index: 0, Value: "A"})
+	c.ListInsertString(ctx, &stricache.StringListInsert{List: "l", Pivot: "c", Value: "x"})
+	n, _ := c.ListInsertString(ctx, &stricache.StringListInsert{List: "l", Pivot: "d", Value: "y", After: true})
+	if n.Count != 7 || fmt.Sprint(values()) != "[A b x c b d y]" {
+		t.Errorf("unexpected list after set and insert %v", values())
+	}
+	if _, err := c.ListInsertString(ctx, &stricache.StringListInsert{List: "l", Pivot: "z", Value: "y"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for a missing pivot, got %v", err)
+	}
+
+	removed, _ := c.ListRemoveString(ctx, &stricache.StringListRemove{List: "l", Value: "b", Count: -1})
+	if removed.Count != 1 || fmt.Sprint(values()) != "[A b x c d y]" {
+		t.Errorf("unexpected list after remove %v", values())
+	}
+	c.ListTrimString(ctx, &stricache.ListRange{List: "l", Start: 1, Stop: -2})
+	if fmt.Sprint(values()) != "[b x c d]" {
+		t.Errorf("unexpected list after trim %v", values())
+	}
+	length, _ := c.ListLenString(ctx, &stricache.ListKey{List: "l"})
+	if length.Count != 4 {
+		t.Errorf("unexpected length %d", length.Count)
+	}
+
+	c.ListTrimString(ctx, &stricache.ListRange{List: "l", Start: 3, Stop: 1})
+	if _, err := c.ListLenString(ctx, &stricache.ListKey{List: "l"}); status.Code(err) != codes.NotFound {
+		t.Errorf("trimming everything should drop the list, got %v", err)
+	}
+	if _, err := c.ListSetInt(ctx, &stricache.IntListSet{Index: 0, Value: 1}); err == nil {
+		t.Error("expected error when changing the unnamed list")
+	}
+}
+
+func TestUnnamedListTracksKeys(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	for _, k := range []string{"a", "b", "c", "d"} {
+		c.AddString(ctx, &stricache.StringItem{Key: k, Value: "same"})
+	}
+	c.DeleteString(ctx, &stricache.GetKey{Key: "b"})
+	c.AddString(ctx, &stricache.StringItem{Key: "a", Value: "moved"})
+
+	values, _ := c.ListRangeString(ctx, &stricache.ListRange{Start: 0, Stop: -1})
+	if fmt.Sprint(values.Values) != "[same same moved]" {
+		t.Errorf("unexpected unnamed list %v", values.Values)
+	}
+	first, _ := c.ListIndexString(ctx, &stricache.ListIndex{Index: 0})
+	if first.Value != "same" {
+		t.Errorf("unexpected first element %v", first)
+	}
+
+	shifted, err := c.ShiftString(ctx, &stricache.ListPop{})
+	if err != nil || shifted.Items[0].Key != "c" {
+		t.Fatalf("expected key c, got %v, %v", shifted, err)
+	}
+	if _, err := c.GetString(ctx, &stricache.GetKey{Key: "d"}); err != nil {
+		t.Error("shift removed another key holding the same value")
+	}
+	popped, _ := c.PopString(ctx, &stricache.ListPop{Count: 2})
+	if len(popped.Items) != 2 || popped.Items[0].Key != "a" || popped.Items[1].Key != "d" {
+		t.Errorf("unexpected pop %v", popped.Items)
+	}
+	length, _ := c.ListLenString(ctx, &stricache.ListKey{})
+	if length.Count != 0 {
+		t.Errorf("expected an empty list, got %d", length.Count)
+	}
+}
+
+func TestHash(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	added, _ := c.HSet(ctx, &stricache.HashFields{Key: "h", Fields: map[string]string{"a": "1", "b": "2", "c": "3"}})
+	if added.Count != 3 {
+		t.Errorf("expected 3 new fields, got %d", added.Count)
+	}
+	added, _ = c.HSet(ctx, &stricache.HashFields{Key: "h", Fields: map[string]string{"a": "10", "d": "4"}})
+	if added.Count != 1 {
+		t.Errorf("expected 1 new field, got %d", added.Count)
+	}
+	if v, err := c.HGet(ctx, &stricache.HashField{Key: "h", Field: "a"}); err != nil || v.Value != "10" {
+		t.Errorf("unexpected field a %v, %v", v, err)
+	}
+	if _, err := c.HGet(ctx, &stricache.HashField{Key: "h", Field: "x"}); err == nil {
+		t.Error("expected an error for a missing field")
+	}
+	values, _ := c.HMGet(ctx, &stricache.HashFieldNames{Key: "h", Fields: []string{"b", "x"}})
+	if !values.Values[0].Found || values.Values[1].Found {
+		t.Errorf("unexpected values %v", values.Values)
+	}
+
+	n, err := c.HIncrBy(ctx, &stricache.HashIncr{Key: "h", Field: "a", Delta: -3})
+	if err != nil || n.Value != 7 {
+		t.Errorf("unexpected increment %v, %v", n, err)
+	}
+	c.HSet(ctx, &stricache.HashFields{Key: "h", Fields: map[string]string{"e": "text"}})
+	if _, err := c.HIncrBy(ctx, &stricache.HashIncr{Key: "h", Field: "e", Delta: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition, got %v", err)
+	}
+
+	keys, _ := c.HKeys(ctx, &stricache.GetKey{Key: "h"})
+	if fmt.Sprint(keys.Values) != "[a b c d e]" {
+		t.Errorf("unexpected keys %v", keys.Values)
+	}
+	var scanned []string
+	page := &stricache.HashScanPage{}
+	for {
+		page, _ = c.HScan(ctx, &stricache.HashScan{Key: "h", Cursor: page.Cursor, Count: 2})
+		for _, v := range page.Values {
+			scanned = append(scanned, v.Field)
+		}
+		if page.Cursor == "" {
+			break
+		}
+	}
+	if fmt.Sprint(scanned) != "[a b c d e]" {
+		t.Errorf("unexpected scan %v", scanned)
+	}
+	page, _ = c.HScan(ctx, &stricache.HashScan{Key: "h", Match: "[bd]"})
+	if len(page.Values) != 2 || page.Values[1].Field != "d" {
+		t.Errorf("unexpected matches %v", page.Values)
+	}
+
+	removed, _ := c.HDel(ctx, &stricache.HashFieldNames{Key: "h", Fields: []string{"a", "b", "c", "d", "e", "x"}})
+	if removed.Count != 5 {
+		t.Errorf("expected 5 removed fields, got %d", removed.Count)
+	}
+	length, _ := c.HLen(ctx, &stricache.GetKey{Key: "h"})
+	if length.Count != 0 {
+		t.Errorf("expected an empty hash, got %d fields", length.Count)
+	}
+}
+
+func TestSet(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	added, _ := c.SAdd(ctx, &stricache.SetMembers{Key: "a", Members: []string{"x", "y", "z", "x"}})
+	if added.Count != 3 {
+		t.Errorf("expected 3 new members, got %d", added.Count)
+	}
+	c.SAdd(ctx, &stricache.SetMembers{Key: "b", Members: []string{"y", "w"}})
+	if m, _ := c.SIsMember(ctx, &stricache.SetMember{Key: "a", Member: "z"}); !m.Member {
+		t.Error("z should be a member of a")
+	}
+
+	union, _ := c.SUnion(ctx, &stricache.SetKeys{Keys: []string{"a", "b"}})
+	inter, _ := c.SInter(ctx, &stricache.SetKeys{Keys: []string{"a", "b"}})
+	diff, _ := c.SDiff(ctx, &stricache.SetKeys{Keys: []string{"a", "b"}})
+	if fmt.Sprint(union.Values, inter.Values, diff.Values) != "[w x y z] [y] [x z]" {
+		t.Errorf("unexpected set algebra %v %v %v", union.Values, inter.Values, diff.Values)
+	}
+	stored, _ := c.SDiffStore(ctx, &stricache.SetStore{Destination: "d", Keys: []string{"a", "b"}})
+	members, _ := c.SMembers(ctx, &stricache.GetKey{Key: "d"})
+	if stored.Count != 2 || fmt.Sprint(members.Values) != "[x z]" {
+		t.Errorf("unexpected stored diff %d %v", stored.Count, members.Values)
+	}
+	c.SInterStore(ctx, &stricache.SetStore{Destination: "d", Keys: []string{"a", "missing"}})
+	if card, _ := c.SCard(ctx, &stricache.GetKey{Key: "d"}); card.Count != 0 {
+		t.Errorf("empty result should delete the destination, got %d members", card.Count)
+	}
+
+	random, _ := c.SRandMember(ctx, &stricache.SetRandom{Key: "a", Count: 5})
+	if len(random.Values) != 3 {
+		t.Errorf("expected every member once, got %v", random.Values)
+	}
+	random, _ = c.SRandMember(ctx, &stricache.SetRandom{Key: "a", Count: -5})
+	if len(random.Values) != 5 {
+		t.Errorf("expected 5 members with repeats, got %v", random.Values)
+	}
+	if _, err := c.SRandMember(ctx, &stricache.SetRandom{Key: "a", Count: math.MinInt64}); status.Code(err) != codes.InvalidArgument || reason(err) != "COUNT_TOO_LARGE" {
+		t.Errorf("expected InvalidArgument COUNT_TOO_LARGE for count MinInt64, got %v", err)
+	}
+	popped, _ := c.SPop(ctx, &stricache.SetRandom{Key: "a", Count: 2})
+	left, _ := c.SCard(ctx, &stricache.GetKey{Key: "a"})
+	if len(popped.Values) != 2 || left.Count != 1 {
+		t.Errorf("unexpected pop %v leaving %d", popped.Values, left.Count)
+	}
+	removed, _ := c.SRem(ctx, &stricache.SetMembers{Key: "b", Members: []string{"w", "q"}})
+	if removed.Count != 1 {
+		t.Errorf("expected 1 removed member, got %d", removed.Count)
+	}
+}
+
+func TestSortedSet(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	members := func(items *stricache.FloatItems) string {
+		var res []string
+		for _, item := range items.Items {
+			res = append(res, fmt.Sprintf("%s:%g", item.Key, item.Value))
+		}
+		return fmt.Sprint(res)
+	}
+	added, _ := c.ZAdd(ctx, &stricache.SortedSetItems{Key: "z", Items: []*stricache.FloatItem{
+		{Key: "a", Value: 3}, {Key: "b", Value: 1}, {Key: "c", Value: 2}, {Key: "d", Value: 2},
+	}})
+	if added.Count != 4 {
+		t.Errorf("expected 4 new members, got %d", added.Count)
+	}
+	if _, err := c.ZAdd(ctx, &stricache.SortedSetItems{Key: "z", Items: []*stricache.FloatItem{{Key: "e", Value: math.NaN()}}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for NaN, got %v", err)
+	}
+	incr, _ := c.ZIncrBy(ctx, &stricache.SortedSetIncr{Key: "z", Member: "b", Delta: 3.5})
+	if incr.Value != 4.5 {
+		t.Errorf("unexpected score %v", incr.Value)
+	}
+
+	all, _ := c.ZRange(ctx, &stricache.RankRange{Key: "z", Start: 0, Stop: -1})
+	if members(all) != "[c:2 d:2 a:3 b:4.5]" {
+		t.Errorf("unexpected order %s", members(all))
+	}
+	top, _ := c.ZRange(ctx, &stricache.RankRange{Key: "z", Start: 0, Stop: 1, Reverse: true})
+	if members(top) != "[b:4.5 a:3]" {
+		t.Errorf("unexpected top %s", members(top))
+	}
+	rank, _ := c.ZRank(ctx, &stricache.SortedSetRank{Key: "z", Member: "a"})
+	revRank, _ := c.ZRank(ctx, &stricache.SortedSetRank{Key: "z", Member: "a", Reverse: true})
+	if rank.Count != 2 || revRank.Count != 1 {
+		t.Errorf("unexpected ranks %d, %d", rank.Count, revRank.Count)
+	}
+
+	byScore, _ := c.ZRangeByScore(ctx, &stricache.ScoreRange{Key: "z", Min: 2, Max: math.Inf(1), MinExclusive: true})
+	if members(byScore) != "[a:3 b:4.5]" {
+		t.Errorf("unexpected score range %s", members(byScore))
+	}
+	page, _ := c.ZRangeByScore(ctx, &stricache.ScoreRange{Key: "z", Min: math.Inf(-1), Max: 3, Reverse: true, Offset: 1, Count: 1})
+	if members(page) != "[d:2]" {
+		t.Errorf("unexpected page %s", members(page))
+	}
+	count, _ := c.ZCount(ctx, &stricache.ScoreRange{Key: "z", Min: 2, Max: 3})
+	if count.Count != 3 {
+		t.Errorf("expected 3 members between 2 and 3, got %d", count.Count)
+	}
+	c.ZAdd(ctx, &stricache.SortedSetItems{Key: "lex", Items: []*stricache.FloatItem{{Key: "apple"}, {Key: "banana"}, {Key: "cherry"}}})
+	byLex, _ := c.ZRangeByLex(ctx, &stricache.LexRange{Key: "lex", Min: "b"})
+	if members(byLex) != "[banana:0 cherry:0]" {
+		t.Errorf("unexpected lex range %s", members(byLex))
+	}
+
+	min, _ := c.ZPopMin(ctx, &stricache.SortedSetPop{Key: "z"})
+	max, _ := c.ZPopMax(ctx, &stricache.SortedSetPop{Key: "z", Count: 2})
+	if members(min) != "[c:2]" || members(max) != "[b:4.5 a:3]" {
+		t.Errorf("unexpected pops %s %s", members(min), members(max))
+	}
+	removed, _ := c.ZRem(ctx, &stricache.SetMembers{Key: "z", Members: []string{"d", "x"}})
+	card, _ := c.ZCard(ctx, &stricache.GetKey{Key: "z"})
+	if removed.Count != 1 || card.Count != 0 {
+		t.Errorf("expected an empty sorted set, removed %d, %d left", removed.Count, card.Count)
+	}
+	if _, err := c.ZScore(ctx, &stricache.SetMember{Key: "z", Member: "d"}); err == nil {
+		t.Error("expected an error for a removed member")
+	}
+}
+
+func TestBytes(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	invalid := []byte{0xff, 0xfe, 0}
+	c.AddBytes(ctx, &stricache.BytesItem{Key: "a", Value: invalid})
+	c.AddBytes(ctx, &stricache.BytesItem{Key: "b", Value: []byte("hello")})
+	if item, err := c.GetBytes(ctx, &stricache.GetKey{Key: "a"}); err != nil || !bytes.Equal(item.Value, invalid) {
+		t.Errorf("unexpected value %v, %v", item, err)
+	}
+
+	length, _ := c.Append(ctx, &stricache.BytesItem{Key: "b", Value: []byte(" world")})
+	if length.Count != 11 {
+		t.Errorf("expected length 11, got %d", length.Count)
+	}
+	length, _ = c.SetRange(ctx, &stricache.BytesSetRange{Key: "b", Offset: 6, Value: []byte("there")})
+	part, _ := c.GetRange(ctx, &stricache.BytesRange{Key: "b", Start: -5, Stop: -1})
+	if length.Count != 11 || string(part.Value) != "there" {
+		t.Errorf("unexpected range %q of %d bytes", part.Value, length.Count)
+	}
+	c.SetRange(ctx, &stricache.BytesSetRange{Key: "c", Offset: 2, Value: []byte("x")})
+	if item, _ := c.GetBytes(ctx, &stricache.GetKey{Key: "c"}); !bytes.Equal(item.Value, []byte{0, 0, 'x'}) {
+		t.Errorf("expected zero padding, got %v", item.Value)
+	}
+
+	shifted, _ := c.ShiftBytes(ctx, &stricache.ListPop{Count: 2})
+	if len(shifted.Items) != 2 || shifted.Items[1].Key != "b" || string(shifted.Items[1].Value) != "hello there" {
+		t.Errorf("appending should keep the key in place, got %v", shifted.Items)
+	}
+
+	c.PushBytes(ctx, &stricache.BytesListItem{List: "l", Value: []byte{1}})
+	c.PushBytes(ctx, &stricache.BytesListItem{List: "l", Value: []byte{2}})
+	c.ListInsertBytes(ctx, &stricache.BytesListInsert{List: "l", Pivot: []byte{2}, Value: []byte{3}})
+	values, _ := c.ListRangeBytes(ctx, &stricache.ListRange{List: "l", Start: 0, Stop: -1})
+	if fmt.Sprint(values.Values) != "[[1] [3] [2]]" {
+		t.Errorf("unexpected list %v", values.Values)
+	}
+	lists, _ := c.Lists(ctx, &stricache.EmptyR{})
+	if len(lists.Lists) != 1 || lists.Lists[0].Type != stricache.ValueType_BYTES {
+		t.Errorf("unexpected lists %v", lists.Lists)
+	}
+}
+
+func TestTiers(t *testing.T) {
+	ctx := context.Background()
+	if tiers, _ := api.NewCacheService(engine.New()).Tiers(ctx, &stricache.EmptyR{}); len(tiers.Tiers) != 0 {
+		t.Errorf("expected no tiers without WithTiers, got %v", tiers.Tiers)
+	}
+
+	e := engine.New(engine.WithShards(1), engine.WithTiers(engine.Tiers{Dir: t.TempDir(), Memory: 10, Disk: 1 << 20}))
+	defer e.Close()
+	c := api.NewCacheService(e)
+	for _, k := range []string{"a", "b", "c"} {
+		c.AddString(ctx, &stricache.StringItem{Key: k, Value: "value"})
+	}
+	if item, err := c.GetString(ctx, &stricache.GetKey{Key: "a"}); err != nil || item.Value != "value" {
+		t.Fatalf("expected the value back from disk, got %v, %v", item, err)
+	}
+	tiers, _ := c.Tiers(ctx, &stricache.EmptyR{})
+	if len(tiers.Tiers) != 2 {
+		t.Fatalf("expected a memory and a disk tier, got %v", tiers.Tiers)
+	}
+	mem, disk := tiers.Tiers[0], tiers.Tiers[1]
+	if mem.Name != "memory" || mem.Keys != 1 || mem.Budget != 10 || mem.Misses != 1 {
+		t.Errorf("unexpected memory tier %v", mem)
+	}
+	if disk.Name != "disk" || disk.Keys != 2 || disk.Bytes != 12 || disk.Hits != 1 {
+		t.Errorf("unexpected disk tier %v", disk)
+	}
+}
+
+func TestStats(t *testing.T) {
+	ctx := context.Background()
+	if stats, _ := api.NewCacheService(engine.New()).Stats(ctx, &stricache.EmptyR{}); len(stats.Tenants) != 0 {
+		t.Errorf("expected no tenants without quotas, got %v", stats.Tenants)
+	}
+
+	c := api.NewCacheService(engine.New(engine.WithQuotas(engine.Quotas{Separator: "/", Default: engine.Quota{MaxKeys: 1}})))
+	c.AddString(ctx, &stricache.StringItem{Key: "team/a", Value: "v"})
+	_, err := c.AddString(ctx, &stricache.StringItem{Key: "team/b", Value: "v"})
+	if status.Code(err) != codes.ResourceExhausted || reason(err) != "KEY_QUOTA_EXCEEDED" || detail[*errdetails.QuotaFailure](err) == nil {
+		t.Errorf("expected ResourceExhausted with a QuotaFailure over the quota, got %v", err)
+	}
+	stats, _ := c.Stats(ctx, &stricache.EmptyR{})
+	want := &stricache.TenantUsage{Tenant: "team", Keys: 1, Bytes: 7, MaxKeys: 1}
+	if len(stats.Tenants) != 1 || !proto.Equal(stats.Tenants[0], want) {
+		t.Errorf("Stats = %v, want %v", stats.Tenants, want)
+	}
+	if res, _ := c.DeleteString(ctx, &stricache.GetKey{Key: "team/a"}); !res.Removed {
+		t.Fatal("expected the key to be removed")
+	}
+	if stats, _ := c.Stats(ctx, &stricache.EmptyR{}); len(stats.Tenants) != 0 {
+		t.Errorf("expected no usage after Delete, got %v", stats.Tenants)
+	}
+}
+
+func TestDocuments(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	doc, _ := structpb.NewStruct(map[string]interface{}{"name": "a", "meta": map[string]interface{}{"x": 1, "y": 2}, "tags": []interface{}{"t"}})
+	value, _ := anypb.New(doc)
+	if _, err := c.SetDocument(ctx, &stricache.DocumentUpdate{Key: "json", Value: value}); err != nil {
+		t.Fatal(err)
+	}
+	patch, _ := structpb.NewStruct(map[string]interface{}{"meta": map[string]interface{}{"x": 10}})
+	value, _ = anypb.New(patch)
+	res, err := c.SetDocument(ctx, &stricache.DocumentUpdate{Key: "json", Value: value, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"meta.x", "tags"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged := &structpb.Struct{}
+	res.Value.UnmarshalTo(merged)
+	if got, _ := json.Marshal(merged.AsMap()); string(got) != `{"meta":{"x":10,"y":2},"name":"a"}` {
+		t.Errorf("unexpected merge %s", got)
+	}
+
+	// a message type the server doesn't link, added as a descriptor
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("person.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Person"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("name"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+				{Name: proto.String("age"), Number: proto.Int32(2), Type: descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+			},
+		}},
+	}
+	person := func(name string, age int32) *anypb.Any {
+		fd, _ := protodesc.NewFile(file, nil)
+		m := dynamicpb.NewMessage(fd.Messages().Get(0))
+		m.Set(m.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString(name))
+		m.Set(m.Descriptor().Fields().ByName("age"), protoreflect.ValueOfInt32(age))
+		value, _ := anypb.New(m)
+		return value
+	}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"age"}}
+	c.SetDocument(ctx, &stricache.DocumentUpdate{Key: "p", Value: person("ann", 30)})
+	if _, err := c.SetDocument(ctx, &stricache.DocumentUpdate{Key: "p", Value: person("", 31), UpdateMask: mask}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for an unknown type, got %v", err)
+	}
+	added, err := c.RegisterTypes(ctx, &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	if err != nil || added.Count != 1 {
+		t.Fatalf("unexpected registration %v, %v", added, err)
+	}
+
+	var buf bytes.Buffer
+	c.Save(&buf)
+	restored := api.NewCacheService(engine.New())
+	if err := restored.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := restored.SetDocument(ctx, &stricache.DocumentUpdate{Key: "p", Value: person("", 31), UpdateMask: mask}); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := restored.GetDocument(ctx, &stricache.GetKey{Key: "p"})
+	// dynamic messages don't serialize fields in a fixed order
+	fd, _ := protodesc.NewFile(file, nil)
+	m, want := dynamicpb.NewMessage(fd.Messages().Get(0)), dynamicpb.NewMessage(fd.Messages().Get(0))
+	proto.Unmarshal(got.Value.Value, m)
+	proto.Unmarshal(person("ann", 31).Value, want)
+	if got.Value.TypeUrl != "type.googleapis.com/test.Person" || !proto.Equal(m, want) {
+		t.Errorf("unexpected document %v", got.Value)
+	}
+	list, _ := restored.ListDocuments(ctx, &stricache.DocumentFilter{TypeUrl: got.Value.TypeUrl})
+	if len(list.Documents) != 1 || list.Documents[0].Key != "p" {
+		t.Errorf("unexpected documents %v", list.Documents)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	if _, err := c.JSONSet(ctx, &stricache.JSONValue{Key: "doc", Path: "$.a", Value: "1"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a new document below the root, got %v", err)
+	}
+	c.JSONSet(ctx, &stricache.JSONValue{Key: "doc", Path: "$", Value: `{"name":"a","n":9007199254740993,"items":[{"v":1},{"v":2.5}]}`})
+	set, _ := c.JSONSet(ctx, &stricache.JSONValue{Key: "doc", Path: "$.tags", Value: `["x"]`})
+	if set.Count != 1 {
+		t.Errorf("expected the new key to be set, got %d", set.Count)
+	}
+
+	incr, err := c.JSONNumIncrBy(ctx, &stricache.JSONNumIncr{Key: "doc", Path: "$.items[*].v", Delta: 1})
+	if err != nil || fmt.Sprint(incr.Values) != "[2 3.5]" {
+		t.Errorf("unexpected increment %v, %v", incr, err)
+	}
+	incr, _ = c.JSONNumIncrBy(ctx, &stricache.JSONNumIncr{Key: "doc", Path: "n", Delta: 1})
+	if incr.Values[0] != "9007199254740994" {
+		t.Errorf("integer lost precision: %v", incr.Values)
+	}
+	if _, err := c.JSONNumIncrBy(ctx, &stricache.JSONNumIncr{Key: "doc", Path: "$.name", Delta: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a string, got %v", err)
+	}
+	lengths, _ := c.JSONArrAppend(ctx, &stricache.JSONValues{Key: "doc", Path: "$.tags", Values: []string{`"y"`, `{"z":true}`}})
+	if fmt.Sprint(lengths.Counts) != "[3]" {
+		t.Errorf("unexpected lengths %v", lengths.Counts)
+	}
+	deleted, _ := c.JSONDel(ctx, &stricache.JSONPath{Key: "doc", Path: "$.tags[*]"})
+	if deleted.Count != 3 {
+		t.Errorf("expected 3 deleted values, got %d", deleted.Count)
+	}
+
+	got, err := c.JSONGet(ctx, &stricache.JSONPaths{Key: "doc", Paths: []string{"$.items[-1]", "$.tags", "$.missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(got.Matches[0].Values, got.Matches[1].Values, len(got.Matches[2].Values)) != `[{"v":3.5}] [[]] 0` {
+		t.Errorf("unexpected matches %v", got.Matches)
+	}
+
+	var buf bytes.Buffer
+	c.Save(&buf)
+	restored := api.NewCacheService(engine.New())
+	if err := restored.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, _ = restored.JSONGet(ctx, &stricache.JSONPaths{Key: "doc"})
+	if got.Matches[0].Values[0] != `{"items":[{"v":2},{"v":3.5}],"n":9007199254740994,"name":"a","tags":[]}` {
+		t.Errorf("unexpected restored document %s", got.Matches[0].Values[0])
+	}
+}
+
+func TestShardedUnnamedList(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New(engine.WithShards(8)))
+	var want []string
+	for i := 0; i < 100; i++ {
+		k := fmt.Sprint("k", i)
+		if i%3 == 0 {
+			c.UnshiftString(ctx, &stricache.StringItem{Key: k, Value: k})
+			want = append([]string{k}, want...)
+		} else {
+			c.AddString(ctx, &stricache.StringItem{Key: k, Value: k})
+			want = append(want, k)
+		}
+	}
+	c.DeleteString(ctx, &stricache.GetKey{Key: "k50"})
+	want = append(want[:indexOf(want, "k50")], want[indexOf(want, "k50")+1:]...)
+
+	values, _ := c.ListRangeString(ctx, &stricache.ListRange{Start: 0, Stop: -1})
+	if fmt.Sprint(values.Values) != fmt.Sprint(want) {
+		t.Errorf("unexpected unnamed list %v", values.Values)
+	}
+	tail, _ := c.ListRangeString(ctx, &stricache.ListRange{Start: -3, Stop: -2})
+	if fmt.Sprint(tail.Values) != fmt.Sprint(want[len(want)-3:len(want)-1]) {
+		t.Errorf("unexpected tail %v", tail.Values)
+	}
+	last, _ := c.ListIndexString(ctx, &stricache.ListIndex{Index: -1})
+	if last.Value != want[len(want)-1] {
+		t.Errorf("unexpected last element %v", last)
+	}
+	shifted, _ := c.ShiftString(ctx, &stricache.ListPop{Count: 3})
+	popped, _ := c.PopString(ctx, &stricache.ListPop{Count: 3})
+	got := []string{}
+	for _, item := range append(shifted.Items, popped.Items...) {
+		got = append(got, item.Key)
+	}
+	n := len(want)
+	if fmt.Sprint(got) != fmt.Sprint([]string{want[0], want[1], want[2], want[n-1], want[n-2], want[n-3]}) {
+		t.Errorf("unexpected keys taken %v", got)
+	}
+}
+
+// TestConcurrentShards runs calls that lock one, several and all shards at
+// once, checking that each writer's keys stay in order in the unnamed list
+// and that nothing deadlocks.
+func TestConcurrentShards(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New(engine.WithShards(16)))
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(3)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				c.AddInt(ctx, &stricache.IntItem{Key: fmt.Sprintf("w%d-%d", w, i), Value: int64(i)})
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				a, b := fmt.Sprint("s", i%7), fmt.Sprint("s", (i+w)%5)
+				c.SAdd(ctx, &stricache.SetMembers{Key: a, Members: []string{fmt.Sprint(i)}})
+				c.SUnionStore(ctx, &stricache.SetStore{Destination: b, Keys: []string{a, b}})
+				c.SInter(ctx, &stricache.SetKeys{Keys: []string{b, a}})
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				c.ListLenInt(ctx, &stricache.ListKey{})
+				c.Lists(ctx, &stricache.EmptyR{})
+				c.Save(&bytes.Buffer{})
+			}
+		}()
+	}
+	wg.Wait()
+
+	items, err := c.ShiftInt(ctx, &stricache.ListPop{Count: 2000})
+	if err != nil || len(items.Items) != 2000 {
+		t.Fatalf("expected 2000 keys, got %v", err)
+	}
+	next := make([]int64, 4)
+	for _, item := range items.Items {
+		var w int
+		fmt.Sscanf(item.Key, "w%d-", &w)
+		if item.Value != next[w] {
+			t.Fatalf("key %s out of order, expected value %d", item.Key, next[w])
+		}
+		next[w]++
+	}
+}
+
+func TestEvictionAcrossShards(t *testing.T) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New(engine.WithShards(8), engine.WithEviction(engine.Eviction{Policy: engine.EvictionNone, MaxKeys: 10})))
+	for i := 0; i < 10; i++ {
+		if _, err := c.AddString(ctx, &stricache.StringItem{Key: fmt.Sprint("k", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.AddString(ctx, &stricache.StringItem{Key: "k10"}); err == nil {
+		t.Error("expected the cache to be full")
+	}
+	if _, err := c.AddString(ctx, &stricache.StringItem{Key: "k3", Value: "again"}); err != nil {
+		t.Errorf("expected an existing key to be replaced, got %v", err)
+	}
+
+	c = api.NewCacheService(engine.New(engine.WithShards(8), engine.WithEviction(engine.Eviction{Policy: engine.EvictionRandom, MaxKeys: 10})))
+	for i := 0; i < 100; i++ {
+		if _, err := c.AddString(ctx, &stricache.StringItem{Key: fmt.Sprint("k", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	length, _ := c.ListLenString(ctx, &stricache.ListKey{})
+	if length.Count != 10 {
+		t.Errorf("expected 10 keys, got %d", length.Count)
+	}
+}
+
+func indexOf(values []string, v string) int {
+	for i := range values {
+		if values[i] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// BenchmarkShards runs a mix of reads and writes on random keys with one
+// shard, which is what a single lock gives, and with the default number.
+func BenchmarkShards(b *testing.B) {
+	ctx := context.Background()
+	keys := make([]string, 1<<14)
+	for i := range keys {
+		keys[i] = fmt.Sprint("k", i)
+	}
+	for _, shards := range []int{1, engine.DefaultShards} {
+		for _, procs := range []int{1, 2, 4, 8, 16, 32, 64} {
+			b.Run(fmt.Sprintf("shards=%d/procs=%d", shards, procs), func(b *testing.B) {
+				defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+				c := api.NewCacheService(engine.New(engine.WithShards(shards)))
+				for _, k := range keys {
+					c.AddString(ctx, &stricache.StringItem{Key: k, Value: "v"})
+				}
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					i := rand.Intn(len(keys))
+					for pb.Next() {
+						k := keys[(i*7919)%len(keys)]
+						if i%4 == 0 {
+							c.AddString(ctx, &stricache.StringItem{Key: k, Value: "v"})
+						} else {
+							c.GetString(ctx, &stricache.GetKey{Key: k})
+						}
+						i++
+					}
+				})
+			})
+		}
+	}
+}
+
+func BenchmarkDeleteString(b *testing.B) {
+	ctx := context.Background()
+	c := api.NewCacheService(engine.New())
+	keys := make([]string, b.N)
+	for i := range keys {
+		keys[i] = fmt.Sprint("k", i)
+		c.AddString(ctx, &stricache.StringItem{Key: keys[i], Value: "v"})
+	}
+	b.ResetTimer()
+	for _, k := range keys {
+		c.DeleteString(ctx, &stricache.GetKey{Key: k})
+	}
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+func (c *Cache) GetRange(ctx context.Context, args *stricache.BytesRange) (*stricache.BytesItem, error) {
+	value, err := c.engine.GetRange(args.Key, args.Start, args.Stop)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.BytesItem{
+		Key:   args.Key,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) SetRange(ctx context.Context, args *stricache.BytesSetRange) (*stricache.Count, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.sizeAt("value", args.Offset, len(args.Value)); err != nil {
+		return nil, err
+	}
+	return toCount(c.engine.SetRange(args.Key, args.Offset, args.Value))
+}
+
+func (c *Cache) Append(ctx context.Context, item *stricache.BytesItem) (*stricache.Count, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	// the engine bounds the length the value grows to
+	if err := c.limits.size("value", len(item.Value)); err != nil {
+		return nil, err
+	}
+	return toCount(c.engine.Append(item.Key, item.Value))
+}
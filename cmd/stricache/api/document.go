@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+func (c *Cache) SetDocument(ctx context.Context, args *stricache.DocumentUpdate) (*stricache.Document, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(args.Value.GetValue())); err != nil {
+		return nil, err
+	}
+	doc, err := c.engine.SetDocument(engine.Document{
+		Key:     args.Key,
+		TypeURL: args.Value.GetTypeUrl(),
+		Value:   args.Value.GetValue(),
+	}, args.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toDocument(doc), nil
+}
+
+func (c *Cache) GetDocument(ctx context.Context, args *stricache.GetKey) (*stricache.Document, error) {
+	doc, err := c.engine.GetDocument(args.Key)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toDocument(doc), nil
+}
+
+func (c *Cache) DeleteDocument(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.engine.DeleteDocument(args.Key),
+	}, nil
+}
+
+func (c *Cache) ListDocuments(ctx context.Context, args *stricache.DocumentFilter) (*stricache.Documents, error) {
+	res := &stricache.Documents{}
+	for _, doc := range c.engine.ListDocuments(args.TypeUrl, args.Prefix) {
+		res.Documents = append(res.Documents, toDocument(doc))
+	}
+	return res, nil
+}
+
+func (c *Cache) RegisterTypes(ctx context.Context, args *descriptorpb.FileDescriptorSet) (*stricache.Count, error) {
+	return toCount(c.engine.RegisterTypes(args.File))
+}
+
+func toDocument(doc engine.Document) *stricache.Document {
+	return &stricache.Document{
+		Key: doc.Key,
+		Value: &anypb.Any{
+			TypeUrl: doc.TypeURL,
+			Value:   doc.Value,
+		},
+	}
+}
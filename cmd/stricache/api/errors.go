@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"github.com/avag-sargsyan/stricache/engine"
+)
+
+// Errors reach clients as status errors with the code of their kind. Every
+// one carries an ErrorInfo whose reason names the error, like
+// KEY_NOT_FOUND, and the codes below add a detail of their own:
+//
+//	InvalidArgument, OutOfRange  BadRequest
+//	NotFound                     ResourceInfo
+//	FailedPrecondition           PreconditionFailure
+//	ResourceExhausted            QuotaFailure
+
+// Domain is the domain of the ErrorInfo of every error.
+const Domain = "stricache"
+
+var kindCodes = map[engine.Kind]codes.Code{
+	engine.InvalidArgument:    codes.InvalidArgument,
+	engine.NotFound:           codes.NotFound,
+	engine.FailedPrecondition: codes.FailedPrecondition,
+	engine.OutOfRange:         codes.OutOfRange,
+	engine.DataLoss:           codes.DataLoss,
+	engine.Internal:           codes.Internal,
+	engine.ResourceExhausted:  codes.ResourceExhausted,
+}
+
+// resourceTypes are the resources NotFound errors are about, by reason.
+var resourceTypes = map[string]string{
+	"KEY_NOT_FOUND":    "key",
+	"FIELD_NOT_FOUND":  "field",
+	"MEMBER_NOT_FOUND": "member",
+	"LIST_NOT_FOUND":   "list",
+	"PIVOT_NOT_FOUND":  "list element",
+}
+
+// toStatus turns engine errors into status errors with the matching code
+// and details. Errors of no known kind are internal.
+func toStatus(err error) error {
+	var e *engine.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &e):
+		reason := e.Reason
+		if reason == "" {
+			reason = e.Kind.String()
+		}
+		return newStatus(kindCodes[e.Kind], reason, e.Msg)
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	return newStatus(codes.Internal, "INTERNAL", err.Error())
+}
+
+// newStatus returns a status error with an ErrorInfo and the detail of its
+// code.
+func newStatus(code codes.Code, reason, msg string) error {
+	details := []protoiface.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: Domain}}
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Description: msg}},
+		})
+	case codes.NotFound:
+		details = append(details, &errdetails.ResourceInfo{ResourceType: resourceTypes[reason], Description: msg})
+	case codes.FailedPrecondition:
+		details = append(details, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: reason, Description: msg}},
+		})
+	case codes.ResourceExhausted:
+		details = append(details, &errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{Subject: Domain, Description: msg}},
+		})
+	}
+	return withDetails(code, msg, details...)
+}
+
+// withDetails returns a status error with details.
+func withDetails(code codes.Code, msg string, details ...protoiface.MessageV1) error {
+	st, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
@@ -0,0 +1,243 @@
+//go:build ignore
+
+// gen writes values.go, the calls of the value types that are served over
+// gRPC. Every value type has the same calls, named after its messages, so
+// serving a new type takes its messages in the proto file, a store in Cache
+// and an entry in types.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+)
+
+// valueType is a value type with messages named after Name, like
+// StringItem and StringListSet, and calls like AddString.
+type valueType struct {
+	Name string
+	// Go is the Go type of values
+	Go string
+	// Store is the field of Cache that holds its engine.Store
+	Store string
+	// Check formats a call that checks a value, empty if values aren't
+	// checked
+	Check string
+}
+
+var types = []valueType{
+	{Name: "String", Go: "string", Store: "strings", Check: `c.limits.size("value", len(%s))`},
+	{Name: "Int", Go: "int64", Store: "ints"},
+	{Name: "Float", Go: "float64", Store: "floats", Check: `c.limits.float("value", %s)`},
+	{Name: "Bytes", Go: "[]byte", Store: "bytes", Check: `c.limits.size("value", len(%s))`},
+}
+
+// check returns the statement that checks value for t, if it has one.
+func check(t valueType, value string) string {
+	if t.Check == "" {
+		return ""
+	}
+	return fmt.Sprintf("if err := "+t.Check+"; err != nil {\nreturn nil, err\n}", value)
+}
+
+var tmpl = template.Must(template.New("values").Funcs(template.FuncMap{"check": check}).Parse(`// Code generated by gen.go. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+{{range .}}
+func (c *Cache) Add{{.Name}}(ctx context.Context, item *stricache.{{.Name}}Item) (*stricache.{{.Name}}Item, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}{{with check . "item.Value"}}
+	{{.}}{{end}}
+	if err := c.{{.Store}}.Add(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) Unshift{{.Name}}(ctx context.Context, item *stricache.{{.Name}}Item) (*stricache.{{.Name}}Item, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}{{with check . "item.Value"}}
+	{{.}}{{end}}
+	if err := c.{{.Store}}.Unshift(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) Get{{.Name}}(ctx context.Context, args *stricache.GetKey) (*stricache.{{.Name}}Item, error) {
+	value, err := c.{{.Store}}.Get(args.Key)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.{{.Name}}Item{
+		Key:   args.Key,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) Delete{{.Name}}(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.{{.Store}}.Delete(args.Key),
+	}, nil
+}
+
+func (c *Cache) Shift{{.Name}}(ctx context.Context, args *stricache.ListPop) (*stricache.{{.Name}}Items, error) {
+	items, err := c.{{.Store}}.Shift(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return to{{.Name}}Items(args.List, items), nil
+}
+
+func (c *Cache) Pop{{.Name}}(ctx context.Context, args *stricache.ListPop) (*stricache.{{.Name}}Items, error) {
+	items, err := c.{{.Store}}.Pop(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return to{{.Name}}Items(args.List, items), nil
+}
+
+func to{{.Name}}Items(list string, items []engine.Item[{{.Go}}]) *stricache.{{.Name}}Items {
+	res := &stricache.{{.Name}}Items{List: list}
+	for _, item := range items {
+		res.Items = append(res.Items, &stricache.{{.Name}}Item{Key: item.Key, Value: item.Value})
+	}
+	return res
+}
+
+func (c *Cache) Push{{.Name}}(ctx context.Context, item *stricache.{{.Name}}ListItem) (*stricache.Success, error) {
+	if err := c.limits.list(item.List); err != nil {
+		return nil, err
+	}{{with check . "item.Value"}}
+	{{.}}{{end}}
+	if err := c.{{.Store}}.Push(item.List, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) Delete{{.Name}}List(ctx context.Context, args *stricache.ListKey) (*stricache.Success, error) {
+	removed, err := c.{{.Store}}.DeleteList(args.List)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+		Removed: removed,
+	}, nil
+}
+
+func (c *Cache) BlockingShift{{.Name}}(ctx context.Context, args *stricache.BlockingPop) (*stricache.{{.Name}}Items, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.{{.Store}}.BlockingShift(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.{{.Name}}Items{List: args.List}
+	if ok {
+		res.Items = []*stricache.{{.Name}}Item{{"{{"}}Key: item.Key, Value: item.Value{{"}}"}}
+	}
+	return res, nil
+}
+
+func (c *Cache) BlockingPop{{.Name}}(ctx context.Context, args *stricache.BlockingPop) (*stricache.{{.Name}}Items, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.{{.Store}}.BlockingPop(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.{{.Name}}Items{List: args.List}
+	if ok {
+		res.Items = []*stricache.{{.Name}}Item{{"{{"}}Key: item.Key, Value: item.Value{{"}}"}}
+	}
+	return res, nil
+}
+
+func (c *Cache) ListRange{{.Name}}(ctx context.Context, args *stricache.ListRange) (*stricache.{{.Name}}List, error) {
+	values, err := c.{{.Store}}.ListRange(args.List, args.Start, args.Stop)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.{{.Name}}List{
+		Values: values,
+	}, nil
+}
+
+func (c *Cache) ListIndex{{.Name}}(ctx context.Context, args *stricache.ListIndex) (*stricache.{{.Name}}ListItem, error) {
+	value, err := c.{{.Store}}.ListIndex(args.List, args.Index)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.{{.Name}}ListItem{
+		List:  args.List,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) ListSet{{.Name}}(ctx context.Context, args *stricache.{{.Name}}ListSet) (*stricache.Success, error) { {{with check . "args.Value"}}
+	{{.}}{{end}}
+	if err := c.{{.Store}}.ListSet(args.List, args.Index, args.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListInsert{{.Name}}(ctx context.Context, args *stricache.{{.Name}}ListInsert) (*stricache.Count, error) { {{with check . "args.Value"}}
+	{{.}}{{end}}
+	return toCount(c.{{.Store}}.ListInsert(args.List, args.Pivot, args.Value, args.After))
+}
+
+func (c *Cache) ListTrim{{.Name}}(ctx context.Context, args *stricache.ListRange) (*stricache.Success, error) {
+	if err := c.{{.Store}}.ListTrim(args.List, args.Start, args.Stop); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListRemove{{.Name}}(ctx context.Context, args *stricache.{{.Name}}ListRemove) (*stricache.Count, error) {
+	return toCount(c.{{.Store}}.ListRemove(args.List, args.Count, args.Value))
+}
+
+func (c *Cache) ListLen{{.Name}}(ctx context.Context, args *stricache.ListKey) (*stricache.Count, error) {
+	return toCount(c.{{.Store}}.ListLen(args.List))
+}
+{{end}}`))
+
+func main() {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, types); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("values.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+func (c *Cache) HSet(ctx context.Context, args *stricache.HashFields) (*stricache.Count, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	for k, v := range args.Fields {
+		if err := c.limits.size("fields", len(k)); err != nil {
+			return nil, err
+		}
+		if err := c.limits.size("fields", len(v)); err != nil {
+			return nil, err
+		}
+	}
+	return toCount(c.engine.HSet(args.Key, args.Fields))
+}
+
+func (c *Cache) HGet(ctx context.Context, args *stricache.HashField) (*stricache.HashValue, error) {
+	value, err := c.engine.HGet(args.Key, args.Field)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.HashValue{
+		Field: args.Field,
+		Value: value,
+		Found: true,
+	}, nil
+}
+
+func (c *Cache) HMGet(ctx context.Context, args *stricache.HashFieldNames) (*stricache.HashValues, error) {
+	return &stricache.HashValues{
+		Values: toHashValues(c.engine.HMGet(args.Key, args.Fields)),
+	}, nil
+}
+
+func (c *Cache) HDel(ctx context.Context, args *stricache.HashFieldNames) (*stricache.Count, error) {
+	return &stricache.Count{
+		Count: int64(c.engine.HDel(args.Key, args.Fields)),
+	}, nil
+}
+
+func (c *Cache) HGetAll(ctx context.Context, args *stricache.GetKey) (*stricache.HashFields, error) {
+	return &stricache.HashFields{
+		Key:    args.Key,
+		Fields: c.engine.HGetAll(args.Key),
+	}, nil
+}
+
+func (c *Cache) HKeys(ctx context.Context, args *stricache.GetKey) (*stricache.StringList, error) {
+	return &stricache.StringList{
+		Values: c.engine.HKeys(args.Key),
+	}, nil
+}
+
+func (c *Cache) HLen(ctx context.Context, args *stricache.GetKey) (*stricache.Count, error) {
+	return &stricache.Count{
+		Count: int64(c.engine.HLen(args.Key)),
+	}, nil
+}
+
+func (c *Cache) HIncrBy(ctx context.Context, args *stricache.HashIncr) (*stricache.IntItem, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("field", len(args.Field)); err != nil {
+		return nil, err
+	}
+	value, err := c.engine.HIncrBy(args.Key, args.Field, args.Delta)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.IntItem{
+		Key:   args.Field,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) HScan(ctx context.Context, args *stricache.HashScan) (*stricache.HashScanPage, error) {
+	cursor, values, err := c.engine.HScan(args.Key, args.Cursor, args.Match, int(args.Count))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.HashScanPage{
+		Cursor: cursor,
+		Values: toHashValues(values),
+	}, nil
+}
+
+func (c *Cache) DeleteHash(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.engine.DeleteHash(args.Key),
+	}, nil
+}
+
+func toHashValues(values []engine.HashValue) []*stricache.HashValue {
+	res := make([]*stricache.HashValue, len(values))
+	for i, v := range values {
+		res[i] = &stricache.HashValue{Field: v.Field, Value: v.Value, Found: v.Found}
+	}
+	return res
+}
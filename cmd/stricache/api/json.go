@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+func (c *Cache) JSONSet(ctx context.Context, args *stricache.JSONValue) (*stricache.Count, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(args.Value)); err != nil {
+		return nil, err
+	}
+	return toCount(c.engine.JSONSet(args.Key, args.Path, args.Value))
+}
+
+func (c *Cache) JSONGet(ctx context.Context, args *stricache.JSONPaths) (*stricache.JSONMatches, error) {
+	matches, err := c.engine.JSONGet(args.Key, args.Paths...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.JSONMatches{}
+	for _, m := range matches {
+		res.Matches = append(res.Matches, &stricache.JSONMatch{Path: m.Path, Values: m.Values})
+	}
+	return res, nil
+}
+
+func (c *Cache) JSONDel(ctx context.Context, args *stricache.JSONPath) (*stricache.Count, error) {
+	return toCount(c.engine.JSONDel(args.Key, args.Path))
+}
+
+func (c *Cache) JSONArrAppend(ctx context.Context, args *stricache.JSONValues) (*stricache.Counts, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.sizes("values", args.Values); err != nil {
+		return nil, err
+	}
+	counts, err := c.engine.JSONArrAppend(args.Key, args.Path, args.Values...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.Counts{}
+	for _, n := range counts {
+		res.Counts = append(res.Counts, int64(n))
+	}
+	return res, nil
+}
+
+func (c *Cache) JSONNumIncrBy(ctx context.Context, args *stricache.JSONNumIncr) (*stricache.JSONMatch, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.float("delta", args.Delta); err != nil {
+		return nil, err
+	}
+	m, err := c.engine.JSONNumIncrBy(args.Key, args.Path, args.Delta)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.JSONMatch{Path: m.Path, Values: m.Values}, nil
+}
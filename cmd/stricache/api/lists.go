@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+var valueTypes = map[string]stricache.ValueType{
+	engine.String.Name: stricache.ValueType_STRING,
+	engine.Int.Name:    stricache.ValueType_INT,
+	engine.Float.Name:  stricache.ValueType_FLOAT,
+	engine.Bytes.Name:  stricache.ValueType_BYTES,
+}
+
+func (c *Cache) Lists(ctx context.Context, e *stricache.EmptyR) (*stricache.ListInfos, error) {
+	res := &stricache.ListInfos{}
+	for _, l := range c.engine.Lists() {
+		res.Lists = append(res.Lists, &stricache.ListInfo{List: l.Name, Type: valueTypes[l.Type], Length: int64(l.Length)})
+	}
+	return res, nil
+}
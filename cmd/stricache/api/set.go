@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+func (c *Cache) SAdd(ctx context.Context, args *stricache.SetMembers) (*stricache.Count, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.sizes("members", args.Members); err != nil {
+		return nil, err
+	}
+	n, err := c.engine.SAdd(args.Key, args.Members)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Count{
+		Count: int64(n),
+	}, nil
+}
+
+func (c *Cache) SRem(ctx context.Context, args *stricache.SetMembers) (*stricache.Count, error) {
+	return &stricache.Count{
+		Count: int64(c.engine.SRem(args.Key, args.Members)),
+	}, nil
+}
+
+func (c *Cache) SIsMember(ctx context.Context, args *stricache.SetMember) (*stricache.IsMember, error) {
+	return &stricache.IsMember{
+		Member: c.engine.SIsMember(args.Key, args.Member),
+	}, nil
+}
+
+func (c *Cache) SMembers(ctx context.Context, args *stricache.GetKey) (*stricache.StringList, error) {
+	return &stricache.StringList{
+		Values: c.engine.SMembers(args.Key),
+	}, nil
+}
+
+func (c *Cache) SCard(ctx context.Context, args *stricache.GetKey) (*stricache.Count, error) {
+	return &stricache.Count{
+		Count: int64(c.engine.SCard(args.Key)),
+	}, nil
+}
+
+func (c *Cache) SRandMember(ctx context.Context, args *stricache.SetRandom) (*stricache.StringList, error) {
+	values, err := c.engine.SRandMember(args.Key, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.StringList{
+		Values: values,
+	}, nil
+}
+
+func (c *Cache) SPop(ctx context.Context, args *stricache.SetRandom) (*stricache.StringList, error) {
+	values, err := c.engine.SPop(args.Key, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.StringList{
+		Values: values,
+	}, nil
+}
+
+func (c *Cache) SUnion(ctx context.Context, args *stricache.SetKeys) (*stricache.StringList, error) {
+	return &stricache.StringList{
+		Values: c.engine.SUnion(args.Keys...),
+	}, nil
+}
+
+func (c *Cache) SInter(ctx context.Context, args *stricache.SetKeys) (*stricache.StringList, error) {
+	return &stricache.StringList{
+		Values: c.engine.SInter(args.Keys...),
+	}, nil
+}
+
+func (c *Cache) SDiff(ctx context.Context, args *stricache.SetKeys) (*stricache.StringList, error) {
+	return &stricache.StringList{
+		Values: c.engine.SDiff(args.Keys...),
+	}, nil
+}
+
+func (c *Cache) SUnionStore(ctx context.Context, args *stricache.SetStore) (*stricache.Count, error) {
+	if err := c.limits.key("destination", args.Destination); err != nil {
+		return nil, err
+	}
+	return toCount(c.engine.SUnionStore(args.Destination, args.Keys...))
+}
+
+func (c *Cache) SInterStore(ctx context.Context, args *stricache.SetStore) (*stricache.Count, error) {
+	if err := c.limits.key("destination", args.Destination); err != nil {
+		return nil, err
+	}
+	return toCount(c.engine.SInterStore(args.Destination, args.Keys...))
+}
+
+func (c *Cache) SDiffStore(ctx context.Context, args *stricache.SetStore) (*stricache.Count, error) {
+	if err := c.limits.key("destination", args.Destination); err != nil {
+		return nil, err
+	}
+	return toCount(c.engine.SDiffStore(args.Destination, args.Keys...))
+}
+
+func (c *Cache) DeleteSet(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.engine.DeleteSet(args.Key),
+	}, nil
+}
+
+func toCount(n int, err error) (*stricache.Count, error) {
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Count{
+		Count: int64(n),
+	}, nil
+}
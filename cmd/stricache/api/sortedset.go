@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+// Sorted set members travel as FloatItems, the member in key.
+
+func (c *Cache) ZAdd(ctx context.Context, args *stricache.SortedSetItems) (*stricache.Count, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	members := make([]engine.ZMember, len(args.Items))
+	for i, item := range args.Items {
+		if err := c.limits.size("items", len(item.Key)); err != nil {
+			return nil, err
+		}
+		if err := c.limits.float("items", item.Value); err != nil {
+			return nil, err
+		}
+		members[i] = engine.ZMember{Member: item.Key, Score: item.Value}
+	}
+	return toCount(c.engine.ZAdd(args.Key, members))
+}
+
+func (c *Cache) ZIncrBy(ctx context.Context, args *stricache.SortedSetIncr) (*stricache.FloatItem, error) {
+	if err := c.limits.key("key", args.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("member", len(args.Member)); err != nil {
+		return nil, err
+	}
+	if err := c.limits.float("delta", args.Delta); err != nil {
+		return nil, err
+	}
+	score, err := c.engine.ZIncrBy(args.Key, args.Member, args.Delta)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.FloatItem{
+		Key:   args.Member,
+		Value: score,
+	}, nil
+}
+
+func (c *Cache) ZScore(ctx context.Context, args *stricache.SetMember) (*stricache.FloatItem, error) {
+	score, err := c.engine.ZScore(args.Key, args.Member)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.FloatItem{
+		Key:   args.Member,
+		Value: score,
+	}, nil
+}
+
+func (c *Cache) ZRank(ctx context.Context, args *stricache.SortedSetRank) (*stricache.Count, error) {
+	return toCount(c.engine.ZRank(args.Key, args.Member, args.Reverse))
+}
+
+func (c *Cache) ZRange(ctx context.Context, args *stricache.RankRange) (*stricache.FloatItems, error) {
+	return &stricache.FloatItems{
+		List:  args.Key,
+		Items: toScores(c.engine.ZRange(args.Key, args.Start, args.Stop, args.Reverse)),
+	}, nil
+}
+
+func (c *Cache) ZRangeByScore(ctx context.Context, args *stricache.ScoreRange) (*stricache.FloatItems, error) {
+	members, err := c.engine.ZRangeByScore(args.Key, scoreRange(args))
+	return toRange(args.Key, members, err)
+}
+
+func (c *Cache) ZRangeByLex(ctx context.Context, args *stricache.LexRange) (*stricache.FloatItems, error) {
+	members, err := c.engine.ZRangeByLex(args.Key, engine.LexRange{
+		Min:          args.Min,
+		Max:          args.Max,
+		MinExclusive: args.MinExclusive,
+		MaxExclusive: args.MaxExclusive,
+		Reverse:      args.Reverse,
+		Offset:       args.Offset,
+		Count:        args.Count,
+	})
+	return toRange(args.Key, members, err)
+}
+
+func (c *Cache) ZCount(ctx context.Context, args *stricache.ScoreRange) (*stricache.Count, error) {
+	return toCount(c.engine.ZCount(args.Key, scoreRange(args)))
+}
+
+func (c *Cache) ZCard(ctx context.Context, args *stricache.GetKey) (*stricache.Count, error) {
+	return &stricache.Count{
+		Count: int64(c.engine.ZCard(args.Key)),
+	}, nil
+}
+
+func (c *Cache) ZRem(ctx context.Context, args *stricache.SetMembers) (*stricache.Count, error) {
+	return &stricache.Count{
+		Count: int64(c.engine.ZRem(args.Key, args.Members)),
+	}, nil
+}
+
+func (c *Cache) ZPopMin(ctx context.Context, args *stricache.SortedSetPop) (*stricache.FloatItems, error) {
+	members, err := c.engine.ZPopMin(args.Key, args.Count)
+	return toRange(args.Key, members, err)
+}
+
+func (c *Cache) ZPopMax(ctx context.Context, args *stricache.SortedSetPop) (*stricache.FloatItems, error) {
+	members, err := c.engine.ZPopMax(args.Key, args.Count)
+	return toRange(args.Key, members, err)
+}
+
+func (c *Cache) DeleteSortedSet(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.engine.DeleteSortedSet(args.Key),
+	}, nil
+}
+
+func scoreRange(args *stricache.ScoreRange) engine.ScoreRange {
+	return engine.ScoreRange{
+		Min:          args.Min,
+		Max:          args.Max,
+		MinExclusive: args.MinExclusive,
+		MaxExclusive: args.MaxExclusive,
+		Reverse:      args.Reverse,
+		Offset:       args.Offset,
+		Count:        args.Count,
+	}
+}
+
+func toRange(key string, members []engine.ZMember, err error) (*stricache.FloatItems, error) {
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.FloatItems{
+		List:  key,
+		Items: toScores(members),
+	}, nil
+}
+
+func toScores(members []engine.ZMember) []*stricache.FloatItem {
+	items := make([]*stricache.FloatItem, len(members))
+	for i, m := range members {
+		items[i] = &stricache.FloatItem{Key: m.Member, Value: m.Score}
+	}
+	return items
+}
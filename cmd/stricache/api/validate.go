@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+// Limits bound the keys and values calls may store. Zero values mean no
+// limit, but keys may never be empty.
+type Limits struct {
+	// MaxKeyLength is the length of keys and list names in bytes.
+	MaxKeyLength int
+	// MaxValueSize is the size of a value in bytes: a string or a byte
+	// value, a member of a set, a field name or value of a hash, a
+	// document or a JSON value.
+	MaxValueSize int
+	// KeyCharset is CharsetAny, CharsetPrintable or CharsetASCII.
+	KeyCharset string
+	// NonFinite is FloatsAllow, FloatsRejectNaN or FloatsReject, for the
+	// values of FloatItems and float lists, sorted set scores and the
+	// deltas of increments. Increments that end at an infinite score are
+	// left to engine.Limits.FiniteScores.
+	NonFinite string
+}
+
+const (
+	// CharsetAny allows any key.
+	CharsetAny = "any"
+	// CharsetPrintable allows printable characters and the ASCII space.
+	CharsetPrintable = "printable"
+	// CharsetASCII allows printable ASCII characters other than the space.
+	CharsetASCII = "ascii"
+
+	// FloatsAllow stores NaN and infinite values, FloatsRejectNaN rejects NaN
+	// and FloatsReject both.
+	FloatsAllow     = "allow"
+	FloatsRejectNaN = "reject_nan"
+	FloatsReject    = "reject"
+)
+
+// Option configures a Cache.
+type Option func(*Cache)
+
+// WithLimits makes the service reject calls that break l.
+func WithLimits(l Limits) Option {
+	return func(c *Cache) {
+		c.limits = l
+	}
+}
+
+// invalid returns an InvalidArgument status error about a field of a
+// request.
+func invalid(field, reason, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	return withDetails(codes.InvalidArgument, msg,
+		&errdetails.ErrorInfo{Reason: reason, Domain: Domain},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: msg}},
+		},
+	)
+}
+
+// key checks a key of a write.
+func (l Limits) key(field, key string) error {
+	if key == "" {
+		return invalid(field, "KEY_EMPTY", "%s must not be empty", field)
+	}
+	if l.MaxKeyLength > 0 && len(key) > l.MaxKeyLength {
+		return invalid(field, "KEY_TOO_LONG", "%s is %d bytes long, the limit is %d", field, len(key), l.MaxKeyLength)
+	}
+	for _, r := range key {
+		switch {
+		case l.KeyCharset == CharsetPrintable && !unicode.IsPrint(r),
+			l.KeyCharset == CharsetASCII && (r <= ' ' || r > '~'):
+			return invalid(field, "KEY_CHARSET", "%s contains %q, which is not %s", field, r, l.KeyCharset)
+		}
+	}
+	return nil
+}
+
+// keys checks several keys.
+func (l Limits) keys(field string, keys []string) error {
+	for _, key := range keys {
+		if err := l.key(field, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// size checks the size of a value.
+func (l Limits) size(field string, n int) error {
+	if l.MaxValueSize > 0 && n > l.MaxValueSize {
+		return invalid(field, "VALUE_TOO_LARGE", "%s is %d bytes, the limit is %d", field, n, l.MaxValueSize)
+	}
+	return nil
+}
+
+// sizeAt checks the end of n bytes written at offset. Negative offsets are
+// left to the engine.
+func (l Limits) sizeAt(field string, offset int64, n int) error {
+	if l.MaxValueSize > 0 && offset > int64(l.MaxValueSize)-int64(n) {
+		return invalid(field, "VALUE_TOO_LARGE", "%s of %d bytes at offset %d ends past the limit of %d bytes", field, n, offset, l.MaxValueSize)
+	}
+	return nil
+}
+
+// sizes checks the size of several values.
+func (l Limits) sizes(field string, values []string) error {
+	for _, v := range values {
+		if err := l.size(field, len(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// float checks a float value.
+func (l Limits) float(field string, v float64) error {
+	switch {
+	case math.IsNaN(v) && (l.NonFinite == FloatsRejectNaN || l.NonFinite == FloatsReject):
+		return invalid(field, "VALUE_NOT_FINITE", "%s must not be NaN", field)
+	case math.IsInf(v, 0) && l.NonFinite == FloatsReject:
+		return invalid(field, "VALUE_NOT_FINITE", "%s must be finite", field)
+	}
+	return nil
+}
+
+// list checks a list name. An empty name is left to the engine, which
+// reports it as LIST_NAME_REQUIRED.
+func (l Limits) list(name string) error {
+	if name == "" {
+		return nil
+	}
+	return l.key("list", name)
+}
+
+// maxTimeoutMs is the longest timeout of a blocking call, the longest
+// time.Duration in milliseconds.
+const maxTimeoutMs = math.MaxInt64 / int64(time.Millisecond)
+
+// blockingTimeout converts the timeout of a blocking call, which would wrap
+// around past maxTimeoutMs. Negative timeouts are left to the engine.
+func blockingTimeout(ms int64) (time.Duration, error) {
+	switch {
+	case ms > maxTimeoutMs:
+		return 0, invalid("timeout_ms", "TIMEOUT_TOO_LARGE", "timeout_ms is %d, the limit is %d", ms, maxTimeoutMs)
+	case ms < 0:
+		return -1, nil
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// Info describes the limits the service enforces, including those the
+// engine enforces itself.
+func (c *Cache) Info(ctx context.Context, e *stricache.EmptyR) (*stricache.ServerInfo, error) {
+	return &stricache.ServerInfo{
+		Limits: &stricache.Limits{
+			MaxKeyLength:  int64(c.limits.MaxKeyLength),
+			MaxValueSize:  int64(c.limits.MaxValueSize),
+			KeyCharset:    c.limits.KeyCharset,
+			NonFinite:     c.limits.NonFinite,
+			MaxListLength: int64(c.engine.Limits().MaxListLength),
+		},
+	}, nil
+}
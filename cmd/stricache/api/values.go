@@ -0,0 +1,727 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+func (c *Cache) AddString(ctx context.Context, item *stricache.StringItem) (*stricache.StringItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(item.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.strings.Add(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) UnshiftString(ctx context.Context, item *stricache.StringItem) (*stricache.StringItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(item.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.strings.Unshift(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) GetString(ctx context.Context, args *stricache.GetKey) (*stricache.StringItem, error) {
+	value, err := c.strings.Get(args.Key)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.StringItem{
+		Key:   args.Key,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) DeleteString(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.strings.Delete(args.Key),
+	}, nil
+}
+
+func (c *Cache) ShiftString(ctx context.Context, args *stricache.ListPop) (*stricache.StringItems, error) {
+	items, err := c.strings.Shift(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toStringItems(args.List, items), nil
+}
+
+func (c *Cache) PopString(ctx context.Context, args *stricache.ListPop) (*stricache.StringItems, error) {
+	items, err := c.strings.Pop(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toStringItems(args.List, items), nil
+}
+
+func toStringItems(list string, items []engine.Item[string]) *stricache.StringItems {
+	res := &stricache.StringItems{List: list}
+	for _, item := range items {
+		res.Items = append(res.Items, &stricache.StringItem{Key: item.Key, Value: item.Value})
+	}
+	return res
+}
+
+func (c *Cache) PushString(ctx context.Context, item *stricache.StringListItem) (*stricache.Success, error) {
+	if err := c.limits.list(item.List); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(item.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.strings.Push(item.List, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) DeleteStringList(ctx context.Context, args *stricache.ListKey) (*stricache.Success, error) {
+	removed, err := c.strings.DeleteList(args.List)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+		Removed: removed,
+	}, nil
+}
+
+func (c *Cache) BlockingShiftString(ctx context.Context, args *stricache.BlockingPop) (*stricache.StringItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.strings.BlockingShift(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.StringItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.StringItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) BlockingPopString(ctx context.Context, args *stricache.BlockingPop) (*stricache.StringItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.strings.BlockingPop(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.StringItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.StringItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) ListRangeString(ctx context.Context, args *stricache.ListRange) (*stricache.StringList, error) {
+	values, err := c.strings.ListRange(args.List, args.Start, args.Stop)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.StringList{
+		Values: values,
+	}, nil
+}
+
+func (c *Cache) ListIndexString(ctx context.Context, args *stricache.ListIndex) (*stricache.StringListItem, error) {
+	value, err := c.strings.ListIndex(args.List, args.Index)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.StringListItem{
+		List:  args.List,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) ListSetString(ctx context.Context, args *stricache.StringListSet) (*stricache.Success, error) {
+	if err := c.limits.size("value", len(args.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.strings.ListSet(args.List, args.Index, args.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListInsertString(ctx context.Context, args *stricache.StringListInsert) (*stricache.Count, error) {
+	if err := c.limits.size("value", len(args.Value)); err != nil {
+		return nil, err
+	}
+	return toCount(c.strings.ListInsert(args.List, args.Pivot, args.Value, args.After))
+}
+
+func (c *Cache) ListTrimString(ctx context.Context, args *stricache.ListRange) (*stricache.Success, error) {
+	if err := c.strings.ListTrim(args.List, args.Start, args.Stop); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListRemoveString(ctx context.Context, args *stricache.StringListRemove) (*stricache.Count, error) {
+	return toCount(c.strings.ListRemove(args.List, args.Count, args.Value))
+}
+
+func (c *Cache) ListLenString(ctx context.Context, args *stricache.ListKey) (*stricache.Count, error) {
+	return toCount(c.strings.ListLen(args.List))
+}
+
+func (c *Cache) AddInt(ctx context.Context, item *stricache.IntItem) (*stricache.IntItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.ints.Add(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) UnshiftInt(ctx context.Context, item *stricache.IntItem) (*stricache.IntItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.ints.Unshift(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) GetInt(ctx context.Context, args *stricache.GetKey) (*stricache.IntItem, error) {
+	value, err := c.ints.Get(args.Key)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.IntItem{
+		Key:   args.Key,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) DeleteInt(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.ints.Delete(args.Key),
+	}, nil
+}
+
+func (c *Cache) ShiftInt(ctx context.Context, args *stricache.ListPop) (*stricache.IntItems, error) {
+	items, err := c.ints.Shift(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toIntItems(args.List, items), nil
+}
+
+func (c *Cache) PopInt(ctx context.Context, args *stricache.ListPop) (*stricache.IntItems, error) {
+	items, err := c.ints.Pop(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toIntItems(args.List, items), nil
+}
+
+func toIntItems(list string, items []engine.Item[int64]) *stricache.IntItems {
+	res := &stricache.IntItems{List: list}
+	for _, item := range items {
+		res.Items = append(res.Items, &stricache.IntItem{Key: item.Key, Value: item.Value})
+	}
+	return res
+}
+
+func (c *Cache) PushInt(ctx context.Context, item *stricache.IntListItem) (*stricache.Success, error) {
+	if err := c.limits.list(item.List); err != nil {
+		return nil, err
+	}
+	if err := c.ints.Push(item.List, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) DeleteIntList(ctx context.Context, args *stricache.ListKey) (*stricache.Success, error) {
+	removed, err := c.ints.DeleteList(args.List)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+		Removed: removed,
+	}, nil
+}
+
+func (c *Cache) BlockingShiftInt(ctx context.Context, args *stricache.BlockingPop) (*stricache.IntItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.ints.BlockingShift(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.IntItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.IntItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) BlockingPopInt(ctx context.Context, args *stricache.BlockingPop) (*stricache.IntItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.ints.BlockingPop(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.IntItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.IntItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) ListRangeInt(ctx context.Context, args *stricache.ListRange) (*stricache.IntList, error) {
+	values, err := c.ints.ListRange(args.List, args.Start, args.Stop)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.IntList{
+		Values: values,
+	}, nil
+}
+
+func (c *Cache) ListIndexInt(ctx context.Context, args *stricache.ListIndex) (*stricache.IntListItem, error) {
+	value, err := c.ints.ListIndex(args.List, args.Index)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.IntListItem{
+		List:  args.List,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) ListSetInt(ctx context.Context, args *stricache.IntListSet) (*stricache.Success, error) {
+	if err := c.ints.ListSet(args.List, args.Index, args.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListInsertInt(ctx context.Context, args *stricache.IntListInsert) (*stricache.Count, error) {
+	return toCount(c.ints.ListInsert(args.List, args.Pivot, args.Value, args.After))
+}
+
+func (c *Cache) ListTrimInt(ctx context.Context, args *stricache.ListRange) (*stricache.Success, error) {
+	if err := c.ints.ListTrim(args.List, args.Start, args.Stop); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListRemoveInt(ctx context.Context, args *stricache.IntListRemove) (*stricache.Count, error) {
+	return toCount(c.ints.ListRemove(args.List, args.Count, args.Value))
+}
+
+func (c *Cache) ListLenInt(ctx context.Context, args *stricache.ListKey) (*stricache.Count, error) {
+	return toCount(c.ints.ListLen(args.List))
+}
+
+func (c *Cache) AddFloat(ctx context.Context, item *stricache.FloatItem) (*stricache.FloatItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.float("value", item.Value); err != nil {
+		return nil, err
+	}
+	if err := c.floats.Add(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) UnshiftFloat(ctx context.Context, item *stricache.FloatItem) (*stricache.FloatItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.float("value", item.Value); err != nil {
+		return nil, err
+	}
+	if err := c.floats.Unshift(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) GetFloat(ctx context.Context, args *stricache.GetKey) (*stricache.FloatItem, error) {
+	value, err := c.floats.Get(args.Key)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.FloatItem{
+		Key:   args.Key,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) DeleteFloat(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.floats.Delete(args.Key),
+	}, nil
+}
+
+func (c *Cache) ShiftFloat(ctx context.Context, args *stricache.ListPop) (*stricache.FloatItems, error) {
+	items, err := c.floats.Shift(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toFloatItems(args.List, items), nil
+}
+
+func (c *Cache) PopFloat(ctx context.Context, args *stricache.ListPop) (*stricache.FloatItems, error) {
+	items, err := c.floats.Pop(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toFloatItems(args.List, items), nil
+}
+
+func toFloatItems(list string, items []engine.Item[float64]) *stricache.FloatItems {
+	res := &stricache.FloatItems{List: list}
+	for _, item := range items {
+		res.Items = append(res.Items, &stricache.FloatItem{Key: item.Key, Value: item.Value})
+	}
+	return res
+}
+
+func (c *Cache) PushFloat(ctx context.Context, item *stricache.FloatListItem) (*stricache.Success, error) {
+	if err := c.limits.list(item.List); err != nil {
+		return nil, err
+	}
+	if err := c.limits.float("value", item.Value); err != nil {
+		return nil, err
+	}
+	if err := c.floats.Push(item.List, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) DeleteFloatList(ctx context.Context, args *stricache.ListKey) (*stricache.Success, error) {
+	removed, err := c.floats.DeleteList(args.List)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+		Removed: removed,
+	}, nil
+}
+
+func (c *Cache) BlockingShiftFloat(ctx context.Context, args *stricache.BlockingPop) (*stricache.FloatItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.floats.BlockingShift(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.FloatItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.FloatItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) BlockingPopFloat(ctx context.Context, args *stricache.BlockingPop) (*stricache.FloatItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.floats.BlockingPop(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.FloatItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.FloatItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) ListRangeFloat(ctx context.Context, args *stricache.ListRange) (*stricache.FloatList, error) {
+	values, err := c.floats.ListRange(args.List, args.Start, args.Stop)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.FloatList{
+		Values: values,
+	}, nil
+}
+
+func (c *Cache) ListIndexFloat(ctx context.Context, args *stricache.ListIndex) (*stricache.FloatListItem, error) {
+	value, err := c.floats.ListIndex(args.List, args.Index)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.FloatListItem{
+		List:  args.List,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) ListSetFloat(ctx context.Context, args *stricache.FloatListSet) (*stricache.Success, error) {
+	if err := c.limits.float("value", args.Value); err != nil {
+		return nil, err
+	}
+	if err := c.floats.ListSet(args.List, args.Index, args.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListInsertFloat(ctx context.Context, args *stricache.FloatListInsert) (*stricache.Count, error) {
+	if err := c.limits.float("value", args.Value); err != nil {
+		return nil, err
+	}
+	return toCount(c.floats.ListInsert(args.List, args.Pivot, args.Value, args.After))
+}
+
+func (c *Cache) ListTrimFloat(ctx context.Context, args *stricache.ListRange) (*stricache.Success, error) {
+	if err := c.floats.ListTrim(args.List, args.Start, args.Stop); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListRemoveFloat(ctx context.Context, args *stricache.FloatListRemove) (*stricache.Count, error) {
+	return toCount(c.floats.ListRemove(args.List, args.Count, args.Value))
+}
+
+func (c *Cache) ListLenFloat(ctx context.Context, args *stricache.ListKey) (*stricache.Count, error) {
+	return toCount(c.floats.ListLen(args.List))
+}
+
+func (c *Cache) AddBytes(ctx context.Context, item *stricache.BytesItem) (*stricache.BytesItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(item.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.bytes.Add(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) UnshiftBytes(ctx context.Context, item *stricache.BytesItem) (*stricache.BytesItem, error) {
+	if err := c.limits.key("key", item.Key); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(item.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.bytes.Unshift(item.Key, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return item, nil
+}
+
+func (c *Cache) GetBytes(ctx context.Context, args *stricache.GetKey) (*stricache.BytesItem, error) {
+	value, err := c.bytes.Get(args.Key)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.BytesItem{
+		Key:   args.Key,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) DeleteBytes(ctx context.Context, args *stricache.GetKey) (*stricache.Success, error) {
+	return &stricache.Success{
+		Success: true,
+		Removed: c.bytes.Delete(args.Key),
+	}, nil
+}
+
+func (c *Cache) ShiftBytes(ctx context.Context, args *stricache.ListPop) (*stricache.BytesItems, error) {
+	items, err := c.bytes.Shift(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toBytesItems(args.List, items), nil
+}
+
+func (c *Cache) PopBytes(ctx context.Context, args *stricache.ListPop) (*stricache.BytesItems, error) {
+	items, err := c.bytes.Pop(args.List, args.Count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toBytesItems(args.List, items), nil
+}
+
+func toBytesItems(list string, items []engine.Item[[]byte]) *stricache.BytesItems {
+	res := &stricache.BytesItems{List: list}
+	for _, item := range items {
+		res.Items = append(res.Items, &stricache.BytesItem{Key: item.Key, Value: item.Value})
+	}
+	return res
+}
+
+func (c *Cache) PushBytes(ctx context.Context, item *stricache.BytesListItem) (*stricache.Success, error) {
+	if err := c.limits.list(item.List); err != nil {
+		return nil, err
+	}
+	if err := c.limits.size("value", len(item.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.bytes.Push(item.List, item.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) DeleteBytesList(ctx context.Context, args *stricache.ListKey) (*stricache.Success, error) {
+	removed, err := c.bytes.DeleteList(args.List)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+		Removed: removed,
+	}, nil
+}
+
+func (c *Cache) BlockingShiftBytes(ctx context.Context, args *stricache.BlockingPop) (*stricache.BytesItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.bytes.BlockingShift(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.BytesItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.BytesItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) BlockingPopBytes(ctx context.Context, args *stricache.BlockingPop) (*stricache.BytesItems, error) {
+	timeout, err := blockingTimeout(args.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	item, ok, err := c.bytes.BlockingPop(ctx, args.List, timeout)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &stricache.BytesItems{List: args.List}
+	if ok {
+		res.Items = []*stricache.BytesItem{{Key: item.Key, Value: item.Value}}
+	}
+	return res, nil
+}
+
+func (c *Cache) ListRangeBytes(ctx context.Context, args *stricache.ListRange) (*stricache.BytesList, error) {
+	values, err := c.bytes.ListRange(args.List, args.Start, args.Stop)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.BytesList{
+		Values: values,
+	}, nil
+}
+
+func (c *Cache) ListIndexBytes(ctx context.Context, args *stricache.ListIndex) (*stricache.BytesListItem, error) {
+	value, err := c.bytes.ListIndex(args.List, args.Index)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.BytesListItem{
+		List:  args.List,
+		Value: value,
+	}, nil
+}
+
+func (c *Cache) ListSetBytes(ctx context.Context, args *stricache.BytesListSet) (*stricache.Success, error) {
+	if err := c.limits.size("value", len(args.Value)); err != nil {
+		return nil, err
+	}
+	if err := c.bytes.ListSet(args.List, args.Index, args.Value); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListInsertBytes(ctx context.Context, args *stricache.BytesListInsert) (*stricache.Count, error) {
+	if err := c.limits.size("value", len(args.Value)); err != nil {
+		return nil, err
+	}
+	return toCount(c.bytes.ListInsert(args.List, args.Pivot, args.Value, args.After))
+}
+
+func (c *Cache) ListTrimBytes(ctx context.Context, args *stricache.ListRange) (*stricache.Success, error) {
+	if err := c.bytes.ListTrim(args.List, args.Start, args.Stop); err != nil {
+		return nil, toStatus(err)
+	}
+	return &stricache.Success{
+		Success: true,
+	}, nil
+}
+
+func (c *Cache) ListRemoveBytes(ctx context.Context, args *stricache.BytesListRemove) (*stricache.Count, error) {
+	return toCount(c.bytes.ListRemove(args.List, args.Count, args.Value))
+}
+
+func (c *Cache) ListLenBytes(ctx context.Context, args *stricache.ListKey) (*stricache.Count, error) {
+	return toCount(c.bytes.ListLen(args.List))
+}
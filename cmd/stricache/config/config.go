@@ -0,0 +1,490 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the effective configuration of the service.
+// Values are resolved in order: defaults, config file, environment, flags.
+type Config struct {
+	Server      Server      `yaml:"server" toml:"server"`
+	Limits      Limits      `yaml:"limits" toml:"limits"`
+	Persistence Persistence `yaml:"persistence" toml:"persistence"`
+	Auth        Auth        `yaml:"auth" toml:"auth"`
+	RateLimit   RateLimit   `yaml:"rate_limit" toml:"rate_limit"`
+	Quota       Quota       `yaml:"quota" toml:"quota"`
+	Eviction    Eviction    `yaml:"eviction" toml:"eviction"`
+	Storage     Storage     `yaml:"storage" toml:"storage"`
+	Log         Log         `yaml:"log" toml:"log"`
+}
+
+type Server struct {
+	Host            string        `yaml:"host" toml:"host"`
+	Port            int           `yaml:"port" toml:"port"`
+	DrainDelay      time.Duration `yaml:"drain_delay" toml:"drain_delay"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+}
+
+type Limits struct {
+	MaxConcurrentStreams uint32 `yaml:"max_concurrent_streams" toml:"max_concurrent_streams"`
+	MaxRecvMsgSize       int    `yaml:"max_recv_msg_size" toml:"max_recv_msg_size"`
+	MaxSendMsgSize       int    `yaml:"max_send_msg_size" toml:"max_send_msg_size"`
+	MaxMemory            uint64 `yaml:"max_memory" toml:"max_memory"`
+	MaxKeyLength         int    `yaml:"max_key_length" toml:"max_key_length"`
+	MaxValueSize         int    `yaml:"max_value_size" toml:"max_value_size"`
+	KeyCharset           string `yaml:"key_charset" toml:"key_charset"`
+	NonFinite            string `yaml:"non_finite" toml:"non_finite"`
+	MaxListLength        int    `yaml:"max_list_length" toml:"max_list_length"`
+}
+
+type Persistence struct {
+	Enabled bool          `yaml:"enabled" toml:"enabled"`
+	Path    string        `yaml:"path" toml:"path"`
+	Timeout time.Duration `yaml:"timeout" toml:"timeout"`
+}
+
+type Auth struct {
+	Enabled bool     `yaml:"enabled" toml:"enabled"`
+	Tokens  []string `yaml:"tokens" toml:"tokens"`
+}
+
+// RateLimit sets the rates of each operation class per client.
+type RateLimit struct {
+	Read  Rate `yaml:"read" toml:"read"`
+	Write Rate `yaml:"write" toml:"write"`
+	Admin Rate `yaml:"admin" toml:"admin"`
+}
+
+type Rate struct {
+	PerSecond int `yaml:"per_second" toml:"per_second"`
+	Burst     int `yaml:"burst" toml:"burst"`
+}
+
+// Quota bounds what each tenant stores. The limits apply to every tenant
+// that Tenants doesn't list; tenants can only be listed in the config file.
+type Quota struct {
+	Enabled       bool                   `yaml:"enabled" toml:"enabled"`
+	Separator     string                 `yaml:"separator" toml:"separator"`
+	MaxKeys       int                    `yaml:"max_keys" toml:"max_keys"`
+	MaxBytes      uint64                 `yaml:"max_bytes" toml:"max_bytes"`
+	MaxListLength int                    `yaml:"max_list_length" toml:"max_list_length"`
+	Tenants       map[string]TenantQuota `yaml:"tenants" toml:"tenants"`
+}
+
+type TenantQuota struct {
+	MaxKeys       int    `yaml:"max_keys" toml:"max_keys"`
+	MaxBytes      uint64 `yaml:"max_bytes" toml:"max_bytes"`
+	MaxListLength int    `yaml:"max_list_length" toml:"max_list_length"`
+}
+
+type Eviction struct {
+	Policy  string `yaml:"policy" toml:"policy"`
+	MaxKeys int    `yaml:"max_keys" toml:"max_keys"`
+}
+
+type Storage struct {
+	Engine string `yaml:"engine" toml:"engine"`
+	Shards int    `yaml:"shards" toml:"shards"`
+	Tier   Tier   `yaml:"tier" toml:"tier"`
+}
+
+// Tier configures the tiered engine.
+type Tier struct {
+	Path   string `yaml:"path" toml:"path"`
+	Memory uint64 `yaml:"memory" toml:"memory"`
+	Disk   uint64 `yaml:"disk" toml:"disk"`
+}
+
+type Log struct {
+	Level  string `yaml:"level" toml:"level"`
+	Format string `yaml:"format" toml:"format"`
+}
+
+// EnvPrefix is prepended to every environment variable read by Load.
+const EnvPrefix = "STRICACHE_"
+
+// Default returns the configuration used when nothing else is set.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			Host:            "127.0.0.1",
+			Port:            7999,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Limits: Limits{
+			MaxConcurrentStreams: 200,
+			MaxRecvMsgSize:       4 << 20,
+			MaxSendMsgSize:       4 << 20,
+			MaxKeyLength:         1024,
+			MaxValueSize:         4 << 20,
+			KeyCharset:           "printable",
+			NonFinite:            "reject_nan",
+		},
+		Persistence: Persistence{
+			Path:    "stricache.snapshot",
+			Timeout: 10 * time.Second,
+		},
+		Quota: Quota{
+			Separator: ":",
+		},
+		Eviction: Eviction{
+			Policy: "noeviction",
+		},
+		Storage: Storage{
+			Engine: "heap",
+			Shards: 32,
+			Tier: Tier{
+				Path:   "stricache.tier",
+				Memory: 256 << 20,
+				Disk:   4 << 30,
+			},
+		},
+		Log: Log{
+			Level:  "info",
+			Format: "text",
+		},
+	}
+}
+
+// setting binds one configuration value to its flag and environment variable.
+// The flag name is the key, the environment variable is EnvPrefix followed by
+// the key in upper case with dots replaced by underscores.
+type setting struct {
+	key   string
+	usage string
+	field func(c *Config) interface{}
+}
+
+var settings = []setting{
+	{"server.host", "address to listen on", func(c *Config) interface{} { return &c.Server.Host }},
+	{"server.port", "port to listen on", func(c *Config) interface{} { return &c.Server.Port }},
+	{"server.drain_delay", "time to report draining before refusing connections", func(c *Config) interface{} { return &c.Server.DrainDelay }},
+	{"server.shutdown_timeout", "max time to wait for in-flight calls on shutdown", func(c *Config) interface{} { return &c.Server.ShutdownTimeout }},
+	{"limits.max_concurrent_streams", "max concurrent streams per connection", func(c *Config) interface{} { return &c.Limits.MaxConcurrentStreams }},
+	{"limits.max_recv_msg_size", "max size of a received message in bytes", func(c *Config) interface{} { return &c.Limits.MaxRecvMsgSize }},
+	{"limits.max_send_msg_size", "max size of a sent message in bytes", func(c *Config) interface{} { return &c.Limits.MaxSendMsgSize }},
+	{"limits.max_memory", "heap size in bytes above which the server reports memory pressure, 0 for unlimited", func(c *Config) interface{} { return &c.Limits.MaxMemory }},
+	{"limits.max_key_length", "max length of keys and list names in bytes, 0 for unlimited", func(c *Config) interface{} { return &c.Limits.MaxKeyLength }},
+	{"limits.max_value_size", "max size of a stored value in bytes, 0 for unlimited", func(c *Config) interface{} { return &c.Limits.MaxValueSize }},
+	{"limits.key_charset", "characters allowed in keys: any, printable or ascii", func(c *Config) interface{} { return &c.Limits.KeyCharset }},
+	{"limits.non_finite", "float values that are stored: allow, reject_nan or reject to refuse NaN and infinities", func(c *Config) interface{} { return &c.Limits.NonFinite }},
+	{"limits.max_list_length", "max elements of a named list, 0 for unlimited", func(c *Config) interface{} { return &c.Limits.MaxListLength }},
+	{"persistence.enabled", "load and save snapshots", func(c *Config) interface{} { return &c.Persistence.Enabled }},
+	{"persistence.path", "snapshot file path", func(c *Config) interface{} { return &c.Persistence.Path }},
+	{"persistence.timeout", "max time to spend writing a snapshot", func(c *Config) interface{} { return &c.Persistence.Timeout }},
+	{"auth.enabled", "require a bearer token on every call", func(c *Config) interface{} { return &c.Auth.Enabled }},
+	{"auth.tokens", "comma separated list of accepted tokens", func(c *Config) interface{} { return &c.Auth.Tokens }},
+	{"rate_limit.read.per_second", "read calls a client may make per second, 0 for unlimited", func(c *Config) interface{} { return &c.RateLimit.Read.PerSecond }},
+	{"rate_limit.read.burst", "read calls a client may make at once, 0 for per_second", func(c *Config) interface{} { return &c.RateLimit.Read.Burst }},
+	{"rate_limit.write.per_second", "write calls a client may make per second, 0 for unlimited", func(c *Config) interface{} { return &c.RateLimit.Write.PerSecond }},
+	{"rate_limit.write.burst", "write calls a client may make at once, 0 for per_second", func(c *Config) interface{} { return &c.RateLimit.Write.Burst }},
+	{"rate_limit.admin.per_second", "admin calls a client may make per second, 0 for unlimited", func(c *Config) interface{} { return &c.RateLimit.Admin.PerSecond }},
+	{"rate_limit.admin.burst", "admin calls a client may make at once, 0 for per_second", func(c *Config) interface{} { return &c.RateLimit.Admin.Burst }},
+	{"quota.enabled", "limit what each tenant stores", func(c *Config) interface{} { return &c.Quota.Enabled }},
+	{"quota.separator", "separator that ends the tenant at the start of keys and list names", func(c *Config) interface{} { return &c.Quota.Separator }},
+	{"quota.max_keys", "max keys per tenant, 0 for unlimited", func(c *Config) interface{} { return &c.Quota.MaxKeys }},
+	{"quota.max_bytes", "max bytes of keys and values per tenant, 0 for unlimited", func(c *Config) interface{} { return &c.Quota.MaxBytes }},
+	{"quota.max_list_length", "max elements of a named list of a tenant, 0 for unlimited", func(c *Config) interface{} { return &c.Quota.MaxListLength }},
+	{"eviction.policy", "noeviction or random", func(c *Config) interface{} { return &c.Eviction.Policy }},
+	{"eviction.max_keys", "max keys per value type, 0 for unlimited", func(c *Config) interface{} { return &c.Eviction.MaxKeys }},
+	{"storage.engine", "heap, arena to keep keys and values out of the garbage collector's way, or tiered to move cold keys to disk", func(c *Config) interface{} { return &c.Storage.Engine }},
+	{"storage.shards", "number of independently locked partitions of the keyspace", func(c *Config) interface{} { return &c.Storage.Shards }},
+	{"storage.tier.path", "directory of the disk tier, emptied on start", func(c *Config) interface{} { return &c.Storage.Tier.Path }},
+	{"storage.tier.memory", "bytes of keys and values kept in memory by the tiered engine", func(c *Config) interface{} { return &c.Storage.Tier.Memory }},
+	{"storage.tier.disk", "bytes of keys and values the tiered engine may move to disk", func(c *Config) interface{} { return &c.Storage.Tier.Disk }},
+	{"log.level", "debug, info, warn or error", func(c *Config) interface{} { return &c.Log.Level }},
+	{"log.format", "text or json", func(c *Config) interface{} { return &c.Log.Format }},
+}
+
+func (s setting) env() string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(s.key, ".", "_"))
+}
+
+// Load builds the configuration from args, the environment and the config
+// file given by -config or STRICACHE_CONFIG. dump reports whether the caller
+// asked for the effective configuration to be printed.
+func Load(args []string) (cfg *Config, dump bool, err error) {
+	fs := flag.NewFlagSet("stricache", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv(EnvPrefix+"CONFIG"), "path to a YAML or TOML config file")
+	fs.BoolVar(&dump, "dump-config", false, "print the effective config and exit")
+
+	flags := map[string]string{}
+	for _, s := range settings {
+		_, isBool := s.field(Default()).(*bool)
+		fs.Var(&flagValue{s.key, flags, isBool}, s.key, fmt.Sprintf("%s (env %s)", s.usage, s.env()))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+
+	cfg = Default()
+	if *path != "" {
+		if err := cfg.readFile(*path); err != nil {
+			return nil, false, err
+		}
+	}
+	for _, s := range settings {
+		if v, ok := os.LookupEnv(s.env()); ok {
+			if err := assign(s.field(cfg), v); err != nil {
+				return nil, false, fmt.Errorf("%s: %v", s.env(), err)
+			}
+		}
+	}
+	for _, s := range settings {
+		if v, ok := flags[s.key]; ok {
+			if err := assign(s.field(cfg), v); err != nil {
+				return nil, false, fmt.Errorf("-%s: %v", s.key, err)
+			}
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, false, err
+	}
+	return cfg, dump, nil
+}
+
+// flagValue records the raw value of a flag so it can be applied after the
+// config file and environment.
+type flagValue struct {
+	key    string
+	values map[string]string
+	isBool bool
+}
+
+func (f *flagValue) String() string   { return "" }
+func (f *flagValue) IsBoolFlag() bool { return f.isBool }
+
+func (f *flagValue) Set(v string) error {
+	f.values[f.key] = v
+	return nil
+}
+
+func (c *Config) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	// unknown keys are errors, so that a misspelled section isn't
+	// silently left at its defaults
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err = dec.Decode(c); err == io.EOF {
+			err = nil
+		}
+	case ".toml":
+		var md toml.MetaData
+		md, err = toml.Decode(string(data), c)
+		if keys := md.Undecoded(); err == nil && len(keys) > 0 {
+			err = fmt.Errorf("unknown key %s", keys[0])
+		}
+	default:
+		return fmt.Errorf("%s: unknown config format, use .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+func assign(field interface{}, v string) error {
+	switch f := field.(type) {
+	case *string:
+		*f = v
+	case *int:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		*f = n
+	case *uint32:
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return err
+		}
+		*f = uint32(n)
+	case *uint64:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*f = n
+	case *bool:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		*f = b
+	case *time.Duration:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*f = d
+	case *[]string:
+		*f = nil
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				*f = append(*f, s)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported setting type %T", field)
+	}
+	return nil
+}
+
+// Validate checks that the configuration can be used to start the service.
+func (c *Config) Validate() error {
+	var errs []string
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Sprintf("server.port must be between 1 and 65535, got %d", c.Server.Port))
+	}
+	if c.Server.DrainDelay < 0 {
+		errs = append(errs, "server.drain_delay must not be negative")
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, "server.shutdown_timeout must be positive")
+	}
+	if c.Limits.MaxConcurrentStreams == 0 {
+		errs = append(errs, "limits.max_concurrent_streams must be positive")
+	}
+	if c.Limits.MaxRecvMsgSize <= 0 {
+		errs = append(errs, "limits.max_recv_msg_size must be positive")
+	}
+	if c.Limits.MaxSendMsgSize <= 0 {
+		errs = append(errs, "limits.max_send_msg_size must be positive")
+	}
+	if c.Limits.MaxKeyLength < 0 {
+		errs = append(errs, "limits.max_key_length must not be negative")
+	}
+	if c.Limits.MaxValueSize < 0 {
+		errs = append(errs, "limits.max_value_size must not be negative")
+	}
+	switch c.Limits.KeyCharset {
+	case "any", "printable", "ascii":
+	default:
+		errs = append(errs, fmt.Sprintf("limits.key_charset must be any, printable or ascii, got %q", c.Limits.KeyCharset))
+	}
+	switch c.Limits.NonFinite {
+	case "allow", "reject_nan", "reject":
+	default:
+		errs = append(errs, fmt.Sprintf("limits.non_finite must be allow, reject_nan or reject, got %q", c.Limits.NonFinite))
+	}
+	if c.Limits.MaxListLength < 0 {
+		errs = append(errs, "limits.max_list_length must not be negative")
+	}
+	if c.Persistence.Enabled && c.Persistence.Path == "" {
+		errs = append(errs, "persistence.path is required when persistence is enabled")
+	}
+	if c.Persistence.Timeout <= 0 {
+		errs = append(errs, "persistence.timeout must be positive")
+	}
+	if c.Auth.Enabled && len(c.Auth.Tokens) == 0 {
+		errs = append(errs, "auth.tokens is required when auth is enabled")
+	}
+	for _, r := range []struct {
+		class string
+		Rate
+	}{{"read", c.RateLimit.Read}, {"write", c.RateLimit.Write}, {"admin", c.RateLimit.Admin}} {
+		if r.PerSecond < 0 || r.Burst < 0 {
+			errs = append(errs, fmt.Sprintf("rate_limit.%s.per_second and burst must not be negative", r.class))
+		}
+	}
+	if c.Quota.Enabled && c.Quota.Separator == "" {
+		errs = append(errs, "quota.separator is required when quotas are enabled")
+	}
+	if c.Quota.MaxKeys < 0 || c.Quota.MaxListLength < 0 {
+		errs = append(errs, "quota.max_keys and max_list_length must not be negative")
+	}
+	if c.Quota.MaxBytes > math.MaxInt64 {
+		errs = append(errs, fmt.Sprintf("quota.max_bytes must be at most %d", int64(math.MaxInt64)))
+	}
+	names := make([]string, 0, len(c.Quota.Tenants))
+	for name := range c.Quota.Tenants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t := c.Quota.Tenants[name]
+		if t.MaxKeys < 0 || t.MaxListLength < 0 {
+			errs = append(errs, fmt.Sprintf("quota.tenants.%s.max_keys and max_list_length must not be negative", name))
+		}
+		if t.MaxBytes > math.MaxInt64 {
+			errs = append(errs, fmt.Sprintf("quota.tenants.%s.max_bytes must be at most %d", name, int64(math.MaxInt64)))
+		}
+	}
+	switch c.Eviction.Policy {
+	case "noeviction", "random":
+	default:
+		errs = append(errs, fmt.Sprintf("eviction.policy must be noeviction or random, got %q", c.Eviction.Policy))
+	}
+	if c.Eviction.MaxKeys < 0 {
+		errs = append(errs, "eviction.max_keys must not be negative")
+	}
+	switch c.Storage.Engine {
+	case "heap", "arena":
+	case "tiered":
+		if c.Storage.Tier.Path == "" {
+			errs = append(errs, "storage.tier.path is required by the tiered engine")
+		}
+		if c.Storage.Tier.Disk == 0 {
+			errs = append(errs, "storage.tier.disk must be positive for the tiered engine")
+		}
+	default:
+		errs = append(errs, fmt.Sprintf("storage.engine must be heap, arena or tiered, got %q", c.Storage.Engine))
+	}
+	if c.Storage.Shards <= 0 {
+		errs = append(errs, "storage.shards must be positive")
+	}
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Sprintf("log.level must be debug, info, warn or error, got %q", c.Log.Level))
+	}
+	switch c.Log.Format {
+	case "text", "json":
+	default:
+		errs = append(errs, fmt.Sprintf("log.format must be text or json, got %q", c.Log.Format))
+	}
+	if len(errs) > 0 {
+		return errors.New("invalid config: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Addr returns the host:port the server listens on.
+func (c *Config) Addr() string {
+	return fmt.Sprintf("%s:%d", c.Server.Host, c.Server.Port)
+}
+
+// Dump writes the configuration as YAML. Auth tokens are masked.
+func (c *Config) Dump(w io.Writer) error {
+	out := *c
+	out.Auth.Tokens = make([]string, len(c.Auth.Tokens))
+	for i := range out.Auth.Tokens {
+		out.Auth.Tokens[i] = "****"
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&out); err != nil {
+		return err
+	}
+	return enc.Close()
+}
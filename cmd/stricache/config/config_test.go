@@ -0,0 +1,175 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaults(t *testing.T) {
+	cfg, dump, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dump {
+		t.Error("dump should be off by default")
+	}
+	if cfg.Addr() != "127.0.0.1:7999" {
+		t.Errorf("unexpected address %s", cfg.Addr())
+	}
+	if cfg.Limits.MaxConcurrentStreams != 200 {
+		t.Errorf("unexpected max streams %d", cfg.Limits.MaxConcurrentStreams)
+	}
+}
+
+func TestPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "stricache.yaml")
+	yml := "server:\n  host: 0.0.0.0\n  port: 8000\nlog:\n  level: debug\npersistence:\n  timeout: 3s\n"
+	if err := os.WriteFile(path, []byte(yml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("STRICACHE_SERVER_PORT", "8001")
+	t.Setenv("STRICACHE_LOG_LEVEL", "warn")
+
+	cfg, _, err := Load([]string{"-config", path, "-log.level", "error", "-auth.enabled", "-auth.tokens", "a, b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Server.Host != "0.0.0.0" {
+		t.Errorf("file value not applied, host is %s", cfg.Server.Host)
+	}
+	if cfg.Server.Port != 8001 {
+		t.Errorf("env should override file, port is %d", cfg.Server.Port)
+	}
+	if cfg.Log.Level != "error" {
+		t.Errorf("flag should override env, level is %s", cfg.Log.Level)
+	}
+	if cfg.Persistence.Timeout != 3*time.Second {
+		t.Errorf("unexpected timeout %s", cfg.Persistence.Timeout)
+	}
+	if len(cfg.Auth.Tokens) != 2 || cfg.Auth.Tokens[1] != "b" {
+		t.Errorf("unexpected tokens %v", cfg.Auth.Tokens)
+	}
+}
+
+func TestTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stricache.toml")
+	tml := "[eviction]\npolicy = \"random\"\nmax_keys = 10\n[persistence]\ntimeout = \"45s\"\n"
+	if err := os.WriteFile(path, []byte(tml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err := Load([]string{"-config", path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Eviction.Policy != "random" || cfg.Eviction.MaxKeys != 10 {
+		t.Errorf("unexpected eviction %+v", cfg.Eviction)
+	}
+	if cfg.Persistence.Timeout != 45*time.Second {
+		t.Errorf("expected a persistence timeout of 45s, got %v", cfg.Persistence.Timeout)
+	}
+}
+
+func TestUnknownKeys(t *testing.T) {
+	for name, content := range map[string]string{
+		"stricache.yaml": "persistance:\n  enabled: true\n",
+		"stricache.toml": "[persistance]\nenabled = true\n",
+		"nested.yaml":    "persistence:\n  enabeld: true\n",
+		"nested.toml":    "[persistence]\nenabeld = true\n",
+	} {
+		path := filepath.Join(t.TempDir(), name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, _, err := Load([]string{"-config", path})
+		if err == nil || !strings.Contains(err.Error(), "persistance") && !strings.Contains(err.Error(), "enabeld") {
+			t.Errorf("%s: expected the unknown key to be reported, got %v", name, err)
+		}
+	}
+}
+
+func TestQuotaTenants(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stricache.yaml")
+	yml := "quota:\n  enabled: true\n  max_keys: 100\n  tenants:\n    team-a:\n      max_bytes: 1024\n"
+	if err := os.WriteFile(path, []byte(yml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err := Load([]string{"-config", path, "-quota.max_list_length", "5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q := cfg.Quota; q.Separator != ":" || q.MaxKeys != 100 || q.MaxListLength != 5 || q.Tenants["team-a"].MaxBytes != 1024 {
+		t.Errorf("unexpected quota %+v", q)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	_, _, err := Load([]string{"-server.port", "0", "-log.format", "xml", "-auth.enabled", "-storage.engine", "disk"})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"server.port", "log.format", "auth.tokens", "storage.engine"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+}
+
+func TestValidateTier(t *testing.T) {
+	_, _, err := Load([]string{"-storage.engine", "tiered", "-storage.tier.path", "", "-storage.tier.disk", "0"})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"storage.tier.path", "storage.tier.disk"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+}
+
+func TestValidateRequestLimits(t *testing.T) {
+	_, _, err := Load([]string{"-limits.key_charset", "latin1", "-limits.non_finite", "nan", "-limits.max_key_length", "-1"})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"limits.key_charset", "limits.non_finite", "limits.max_key_length"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+}
+
+func TestValidateQuotaBytes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stricache.yaml")
+	yml := "quota:\n  max_bytes: 9223372036854775808\n  tenants:\n    team-a:\n      max_bytes: 18446744073709551615\n"
+	if err := os.WriteFile(path, []byte(yml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err := Load([]string{"-config", path})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"quota.max_bytes", "quota.tenants.team-a.max_bytes"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+}
+
+func TestDumpMasksTokens(t *testing.T) {
+	cfg := Default()
+	cfg.Auth.Tokens = []string{"secret"}
+	var b strings.Builder
+	if err := cfg.Dump(&b); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(b.String(), "secret") {
+		t.Errorf("dump leaks token:\n%s", b.String())
+	}
+	if !strings.Contains(b.String(), "timeout: 10s") {
+		t.Errorf("dump should print durations as strings:\n%s", b.String())
+	}
+}
@@ -0,0 +1,94 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+func (l Level) String() string {
+	switch l {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	default:
+		return "error"
+	}
+}
+
+// ParseLevel converts a level name from the config into a Level.
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return Debug, nil
+	case "info":
+		return Info, nil
+	case "warn":
+		return Warn, nil
+	case "error":
+		return Error, nil
+	}
+	return Info, fmt.Errorf("unknown log level %q", s)
+}
+
+// Logger writes leveled messages as plain text or JSON lines.
+type Logger struct {
+	level Level
+	json  bool
+	out   io.Writer
+	mu    sync.Mutex
+}
+
+func New(out io.Writer, level Level, format string) *Logger {
+	return &Logger{
+		level: level,
+		json:  format == "json",
+		out:   out,
+	}
+}
+
+func (l *Logger) Enabled(level Level) bool {
+	return level >= l.level
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) { l.logf(Debug, format, args...) }
+func (l *Logger) Infof(format string, args ...interface{})  { l.logf(Info, format, args...) }
+func (l *Logger) Warnf(format string, args ...interface{})  { l.logf(Warn, format, args...) }
+func (l *Logger) Errorf(format string, args ...interface{}) { l.logf(Error, format, args...) }
+
+func (l *Logger) logf(level Level, format string, args ...interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	msg := fmt.Sprintf(format, args...)
+	var line []byte
+	if l.json {
+		line, _ = json.Marshal(struct {
+			Time  string `json:"time"`
+			Level string `json:"level"`
+			Msg   string `json:"msg"`
+		}{now, level.String(), msg})
+		line = append(line, '\n')
+	} else {
+		line = []byte(fmt.Sprintf("%s %-5s %s\n", now, strings.ToUpper(level.String()), msg))
+	}
+	l.mu.Lock()
+	l.out.Write(line)
+	l.mu.Unlock()
+}
@@ -1,33 +1,103 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net"
-	
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
-	"github.com/avag-sargsyan/stricache/proto/stricache"
-	"github.com/avag-sargsyan/stricache/cmd/stricache/api"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/avag-sargsyan/stricache/cmd/stricache/config"
+	"github.com/avag-sargsyan/stricache/cmd/stricache/logger"
+	"github.com/avag-sargsyan/stricache/cmd/stricache/server"
+)
+
+// Exit codes
+const (
+	exitOK = iota
+	exitError
+	exitConfig
+	exitForcedStop
+	exitFlush
 )
 
 func main() {
-	opts := []grpc.ServerOption{
-		grpc.MaxConcurrentStreams(200),
+	os.Exit(run())
+}
+
+func run() int {
+	cfg, dump, err := config.Load(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitConfig
+	}
+	if dump {
+		if err := cfg.Dump(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitError
+		}
+		return exitOK
 	}
 
-	// create a gRPC server object
-	grpcServer := grpc.NewServer(opts...)
-	stricache.RegisterStricacheServiceServer(grpcServer, api.NewCacheService())
+	level, _ := logger.ParseLevel(cfg.Log.Level)
+	log := logger.New(os.Stderr, level, cfg.Log.Format)
 
-	reflection.Register(grpcServer)
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
 
-	lis, err := net.Listen("tcp", "127.0.0.1:7999")
+	srv := server.New(cfg, log)
+	lis, err := net.Listen("tcp", cfg.Addr())
 	if err != nil {
-		log.Fatalf("Error in starting server %v", err)
+		log.Errorf("Error in starting server %v", err)
+		return exitError
 	}
-	fmt.Println("Started the server on: 7999")
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("err in serving gRPC %v\n", err)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(lis)
+	}()
+	log.Infof("Started the server on: %s", cfg.Addr())
+
+	// health checks report NOT_SERVING until the snapshot is loaded
+	if err := srv.Restore(); err != nil {
+		log.Errorf("Error in loading snapshot %v", err)
+		return exitError
+	}
+	select {
+	case err := <-serveErr:
+		log.Errorf("err in serving gRPC %v", err)
+		return exitError
+	case sig := <-signals:
+		log.Infof("Received %s, shutting down", sig)
+	}
+
+	// a second signal skips the drain and cancels in-flight calls
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.DrainDelay+cfg.Server.ShutdownTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case sig := <-signals:
+			log.Warnf("Received %s again, stopping now", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	srv.Drain()
+	select {
+	case <-time.After(cfg.Server.DrainDelay):
+	case <-ctx.Done():
+	}
+
+	switch err := srv.Shutdown(ctx); {
+	case err == server.ErrForcedStop:
+		log.Warnf("Stopped with in-flight calls cancelled")
+		return exitForcedStop
+	case err != nil:
+		log.Errorf("Error in saving snapshot %v", err)
+		return exitFlush
 	}
+	log.Infof("Stopped")
+	return exitOK
 }
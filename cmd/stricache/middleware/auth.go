@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TokenFromContext returns the bearer token sent in the "authorization"
+// metadata of an incoming call, or "" if there is none.
+func TokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if strings.HasPrefix(v, "Bearer ") {
+			return strings.TrimPrefix(v, "Bearer ")
+		}
+	}
+	return ""
+}
+
+// authenticated is the context key of the token Auth accepted.
+type authenticated struct{}
+
+// AuthenticatedToken returns the bearer token Auth accepted for the call,
+// and false if Auth didn't check the call.
+func AuthenticatedToken(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(authenticated{}).(string)
+	return token, ok
+}
+
+// Auth rejects calls that don't carry one of the given bearer tokens.
+// Health checks are let through so that probes don't need a token. The
+// handler finds the accepted token with AuthenticatedToken.
+func Auth(tokens []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") {
+			return handler(ctx, req)
+		}
+		token := TokenFromContext(ctx)
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		for _, t := range tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+				return handler(context.WithValue(ctx, authenticated{}, token), req)
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/avag-sargsyan/stricache/cmd/stricache/logger"
+)
+
+// Logging logs every call at debug level and failed calls at warn level.
+func Logging(log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if err != nil {
+			log.Warnf("%s failed after %s: %v", info.FullMethod, time.Since(start), err)
+		} else {
+			log.Debugf("%s took %s", info.FullMethod, time.Since(start))
+		}
+		return resp, err
+	}
+}
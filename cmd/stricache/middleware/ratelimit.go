@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Operation classes of StricacheService calls.
+const (
+	OpRead  = "read"
+	OpWrite = "write"
+	OpAdmin = "admin"
+)
+
+var adminCalls = map[string]bool{
+	"Lists":         true,
+	"Tiers":         true,
+	"Info":          true,
+	"Stats":         true,
+	"RegisterTypes": true,
+}
+
+var readCalls = map[string]bool{
+	"HGet": true, "HMGet": true, "HGetAll": true, "HKeys": true, "HLen": true, "HScan": true,
+	"SIsMember": true, "SMembers": true, "SCard": true, "SRandMember": true,
+	"SUnion": true, "SInter": true, "SDiff": true,
+	"ZScore": true, "ZRank": true, "ZRange": true, "ZRangeByScore": true, "ZRangeByLex": true,
+	"ZCount": true, "ZCard": true,
+	"ListDocuments": true, "JSONGet": true,
+}
+
+// readPrefixes start the names of reads that exist for every value type.
+var readPrefixes = []string{"Get", "ListRange", "ListIndex", "ListLen"}
+
+// OpClass returns the operation class of a full method name. Calls that
+// aren't known to only read are writes.
+func OpClass(method string) string {
+	name := method[strings.LastIndex(method, "/")+1:]
+	switch {
+	case adminCalls[name]:
+		return OpAdmin
+	case readCalls[name]:
+		return OpRead
+	}
+	for _, p := range readPrefixes {
+		if strings.HasPrefix(name, p) {
+			return OpRead
+		}
+	}
+	return OpWrite
+}
+
+// Rate allows PerSecond calls a second on average and up to Burst calls at
+// once. A PerSecond of 0 means no limit, a Burst of 0 one second's worth.
+type Rate struct {
+	PerSecond float64
+	Burst     int
+}
+
+// Rates are the rates of each operation class.
+type Rates struct {
+	Read, Write, Admin Rate
+}
+
+func (r Rates) of(class string) Rate {
+	switch class {
+	case OpRead:
+		return r.Read
+	case OpAdmin:
+		return r.Admin
+	}
+	return r.Write
+}
+
+// RetryAfter is the trailer of rate limited calls that holds the number of
+// seconds to wait before trying again.
+const RetryAfter = "retry-after"
+
+// sweepEvery is how often buckets that have filled up again are dropped.
+const sweepEvery = time.Minute
+
+// bucket is a token bucket of one client and operation class.
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// full is when the bucket is full again and can be dropped
+	full time.Time
+}
+
+type limiter struct {
+	rates Rates
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+// take spends a token of the bucket of key, and returns how long to wait
+// for one if there is none.
+func (l *limiter) take(key string, rate Rate) (time.Duration, bool) {
+	burst := float64(rate.Burst)
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(rate.PerSecond))
+	}
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.swept) >= sweepEvery {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate.PerSecond)
+	b.last = now
+	if b.tokens < 1 {
+		return seconds((1 - b.tokens) / rate.PerSecond), false
+	}
+	b.tokens--
+	b.full = now.Add(seconds((burst - b.tokens) / rate.PerSecond))
+	return 0, true
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// sweep drops the buckets that have filled up again, as a new bucket
+// would be the same.
+func (l *limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if !now.Before(b.full) {
+			delete(l.buckets, key)
+		}
+	}
+	l.swept = now
+}
+
+// client identifies the caller of a call by the bearer token Auth accepted,
+// or by its peer's host otherwise. Tokens Auth didn't check are ignored, as
+// anyone could send a new one to get a new bucket.
+func client(ctx context.Context) string {
+	if token, ok := AuthenticatedToken(ctx); ok {
+		return "token " + token
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return "peer " + addr
+}
+
+// RateLimit rejects calls of clients that exceed the rate of the call's
+// operation class with ResourceExhausted. The rejection carries a RetryInfo
+// detail and a RetryAfter trailer. Health checks are never limited. Chained
+// after Auth, it gives every accepted token a bucket of its own; otherwise
+// clients are told apart by host.
+func RateLimit(rates Rates) grpc.UnaryServerInterceptor {
+	l := &limiter{rates: rates, now: time.Now, buckets: map[string]*bucket{}}
+	return l.intercept
+}
+
+func (l *limiter) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") {
+		return handler(ctx, req)
+	}
+	class := OpClass(info.FullMethod)
+	rate := l.rates.of(class)
+	if rate.PerSecond <= 0 {
+		return handler(ctx, req)
+	}
+	wait, ok := l.take(class+" "+client(ctx), rate)
+	if ok {
+		return handler(ctx, req)
+	}
+	retry := int64(math.Ceil(wait.Seconds()))
+	grpc.SetTrailer(ctx, metadata.Pairs(RetryAfter, strconv.FormatInt(retry, 10)))
+	msg := "too many " + class + " calls, retry in " + wait.Round(time.Millisecond).String()
+	st, err := status.New(codes.ResourceExhausted, msg).WithDetails(
+		&errdetails.ErrorInfo{Reason: "RATE_LIMITED", Domain: "stricache", Metadata: map[string]string{"class": class}},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)},
+	)
+	if err != nil {
+		return nil, status.Error(codes.ResourceExhausted, msg)
+	}
+	return nil, st.Err()
+}
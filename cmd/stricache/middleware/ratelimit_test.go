@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestOpClass(t *testing.T) {
+	for method, want := range map[string]string{
+		"/stricache.StricacheService/GetBytes":      OpRead,
+		"/stricache.StricacheService/ListLenInt":    OpRead,
+		"/stricache.StricacheService/SUnion":        OpRead,
+		"/stricache.StricacheService/SUnionStore":   OpWrite,
+		"/stricache.StricacheService/ListSetString": OpWrite,
+		"/stricache.StricacheService/Info":          OpAdmin,
+	} {
+		if got := OpClass(method); got != want {
+			t.Errorf("OpClass(%s) = %s, want %s", method, got, want)
+		}
+	}
+}
+
+func TestBucketRefills(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := &limiter{now: func() time.Time { return now }, buckets: map[string]*bucket{}}
+	rate := Rate{PerSecond: 2, Burst: 1}
+
+	if _, ok := l.take("a", rate); !ok {
+		t.Fatal("expected the first call to go through")
+	}
+	if wait, ok := l.take("a", rate); ok || wait != 500*time.Millisecond {
+		t.Fatalf("expected to wait 500ms, got %v, %v", wait, ok)
+	}
+	if _, ok := l.take("b", rate); !ok {
+		t.Fatal("expected another key to have its own bucket")
+	}
+	now = now.Add(500 * time.Millisecond)
+	if _, ok := l.take("a", rate); !ok {
+		t.Fatal("expected a token after 500ms")
+	}
+
+	now = now.Add(sweepEvery)
+	l.take("a", rate)
+	if len(l.buckets) != 1 {
+		t.Errorf("expected the idle bucket to be dropped, have %d buckets", len(l.buckets))
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4000}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer secret"))
+	if got := client(ctx); got != "peer 10.0.0.1" {
+		t.Errorf("expected a token Auth didn't check to be ignored, got %q", got)
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/stricache.StricacheService/AddString"}
+	var got string
+	_, err := Auth([]string{"secret"})(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = client(ctx)
+		return nil, nil
+	})
+	if err != nil || got != "token secret" {
+		t.Errorf("expected an accepted token to identify the client, got %q, %v", got, err)
+	}
+}
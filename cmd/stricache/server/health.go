@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+// Health check service names. The empty name and the cache service report
+// overall readiness, the others report one subsystem each.
+// ReplicationHealth is always SERVING, as there is no replication to catch
+// up with yet; it is served so that probes can already be set up for it.
+const (
+	PersistenceHealth = "stricache.persistence"
+	MemoryHealth      = "stricache.memory"
+	ReplicationHealth = "stricache.replication"
+)
+
+const memoryCheckInterval = time.Second
+
+// updateHealth publishes the state of each subsystem. Once the server is
+// draining the health server ignores updates and reports NOT_SERVING.
+func (s *Server) updateHealth() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set := func(service string, ok bool) {
+		st := healthpb.HealthCheckResponse_NOT_SERVING
+		if ok {
+			st = healthpb.HealthCheckResponse_SERVING
+		}
+		s.health.SetServingStatus(service, st)
+	}
+	set(PersistenceHealth, s.loaded)
+	set(MemoryHealth, !s.memoryPressure)
+	set(ReplicationHealth, true)
+	ready := s.loaded && !s.memoryPressure
+	set("", ready)
+	set(stricache.StricacheService_ServiceDesc.ServiceName, ready)
+}
+
+func (s *Server) setLoaded(loaded bool) {
+	s.mu.Lock()
+	s.loaded = loaded
+	s.mu.Unlock()
+	s.updateHealth()
+}
+
+// watchMemory reports memory pressure while the heap is above the configured
+// limit. It returns when stop is closed.
+func (s *Server) watchMemory(stop <-chan struct{}) {
+	if s.cfg.Limits.MaxMemory == 0 {
+		return
+	}
+	ticker := time.NewTicker(memoryCheckInterval)
+	defer ticker.Stop()
+	var m runtime.MemStats
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		runtime.ReadMemStats(&m)
+		pressure := m.HeapAlloc > s.cfg.Limits.MaxMemory
+		s.mu.Lock()
+		changed := pressure != s.memoryPressure
+		s.memoryPressure = pressure
+		s.mu.Unlock()
+		if changed {
+			if pressure {
+				s.log.Warnf("Heap at %d bytes is over the %d byte limit", m.HeapAlloc, s.cfg.Limits.MaxMemory)
+			} else {
+				s.log.Infof("Heap back under the memory limit")
+			}
+			s.updateHealth()
+		}
+	}
+}
+
+// rejectUntilLoaded keeps cache calls out while a snapshot is being loaded.
+func (s *Server) rejectUntilLoaded(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, "/"+stricache.StricacheService_ServiceDesc.ServiceName+"/") {
+		s.mu.Lock()
+		loaded := s.loaded
+		s.mu.Unlock()
+		if !loaded {
+			return nil, status.Error(codes.Unavailable, "loading snapshot")
+		}
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/avag-sargsyan/stricache/cmd/stricache/api"
+	"github.com/avag-sargsyan/stricache/cmd/stricache/config"
+	"github.com/avag-sargsyan/stricache/cmd/stricache/logger"
+	"github.com/avag-sargsyan/stricache/cmd/stricache/middleware"
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+// ErrForcedStop is returned by Shutdown when in-flight calls did not finish
+// before the deadline and had to be cancelled.
+var ErrForcedStop = errors.New("in-flight calls did not finish in time")
+
+// Server ties the cache to a gRPC server and manages its lifecycle.
+type Server struct {
+	cfg      *config.Config
+	log      *logger.Logger
+	cache    *api.Cache
+	grpc     *grpc.Server
+	health   *health.Server
+	draining int32
+	stop     chan struct{}
+
+	// shutdown runs Shutdown once, shutdownErr is what it returned
+	shutdown    sync.Once
+	shutdownErr error
+	// flushes counts the snapshots being written, including those Flush
+	// gave up waiting for, and flushing is their number
+	flushes  sync.WaitGroup
+	flushing int32
+
+	mu             sync.Mutex
+	loaded         bool
+	memoryPressure bool
+}
+
+// newEngine returns the storage engine cfg selects.
+func newEngine(cfg *config.Config) engine.Engine {
+	opts := []engine.Option{
+		engine.WithEviction(engine.Eviction{
+			Policy:  cfg.Eviction.Policy,
+			MaxKeys: cfg.Eviction.MaxKeys,
+		}),
+		engine.WithShards(cfg.Storage.Shards),
+		engine.WithLimits(engine.Limits{
+			MaxListLength:   cfg.Limits.MaxListLength,
+			MaxBytesLen:     cfg.Limits.MaxValueSize,
+			MaxDocumentSize: cfg.Limits.MaxValueSize,
+			FiniteScores:    cfg.Limits.NonFinite == api.FloatsReject,
+		}),
+	}
+	if cfg.Quota.Enabled {
+		opts = append(opts, engine.WithQuotas(quotas(cfg.Quota)))
+	}
+	switch cfg.Storage.Engine {
+	case "arena":
+		opts = append(opts, engine.WithArena())
+	case "tiered":
+		opts = append(opts, engine.WithTiers(engine.Tiers{
+			Dir:    cfg.Storage.Tier.Path,
+			Memory: int64(cfg.Storage.Tier.Memory),
+			Disk:   int64(cfg.Storage.Tier.Disk),
+		}))
+	}
+	return engine.New(opts...)
+}
+
+func quotas(q config.Quota) engine.Quotas {
+	res := engine.Quotas{
+		Separator: q.Separator,
+		Default:   quota(config.TenantQuota{MaxKeys: q.MaxKeys, MaxBytes: q.MaxBytes, MaxListLength: q.MaxListLength}),
+		Tenants:   map[string]engine.Quota{},
+	}
+	for name, t := range q.Tenants {
+		res.Tenants[name] = quota(t)
+	}
+	return res
+}
+
+// quota converts q, whose MaxBytes Validate keeps within int64.
+func quota(q config.TenantQuota) engine.Quota {
+	return engine.Quota{MaxKeys: int64(q.MaxKeys), MaxBytes: int64(q.MaxBytes), MaxListLength: q.MaxListLength}
+}
+
+func rate(r config.Rate) middleware.Rate {
+	return middleware.Rate{PerSecond: float64(r.PerSecond), Burst: r.Burst}
+}
+
+// New creates a server that reports NOT_SERVING until Restore is called.
+func New(cfg *config.Config, log *logger.Logger) *Server {
+	limits := api.Limits{
+		MaxKeyLength: cfg.Limits.MaxKeyLength,
+		MaxValueSize: cfg.Limits.MaxValueSize,
+		KeyCharset:   cfg.Limits.KeyCharset,
+		NonFinite:    cfg.Limits.NonFinite,
+	}
+	s := &Server{
+		cfg:    cfg,
+		log:    log,
+		cache:  api.NewCacheService(newEngine(cfg), api.WithLimits(limits)),
+		health: health.NewServer(),
+		stop:   make(chan struct{}),
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.Logging(log),
+	}
+	if cfg.Auth.Enabled {
+		interceptors = append(interceptors, middleware.Auth(cfg.Auth.Tokens))
+	}
+	interceptors = append(interceptors, middleware.RateLimit(middleware.Rates{
+		Read:  rate(cfg.RateLimit.Read),
+		Write: rate(cfg.RateLimit.Write),
+		Admin: rate(cfg.RateLimit.Admin),
+	}))
+	interceptors = append(interceptors, s.rejectUntilLoaded)
+	opts := []grpc.ServerOption{
+		grpc.MaxConcurrentStreams(cfg.Limits.MaxConcurrentStreams),
+		grpc.MaxRecvMsgSize(cfg.Limits.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.Limits.MaxSendMsgSize),
+		grpc.ChainUnaryInterceptor(interceptors...),
+	}
+	s.grpc = grpc.NewServer(opts...)
+
+	stricache.RegisterStricacheServiceServer(s.grpc, s.cache)
+	healthpb.RegisterHealthServer(s.grpc, s.health)
+	reflection.Register(s.grpc)
+	s.updateHealth()
+	return s
+}
+
+// Restore loads the snapshot from the persistence path, if there is one,
+// and marks the server as ready.
+func (s *Server) Restore() error {
+	if !s.cfg.Persistence.Enabled {
+		s.setLoaded(true)
+		return nil
+	}
+	f, err := os.Open(s.cfg.Persistence.Path)
+	if os.IsNotExist(err) {
+		s.log.Infof("No snapshot at %s, starting empty", s.cfg.Persistence.Path)
+		s.setLoaded(true)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := s.cache.Load(f); err != nil {
+		return err
+	}
+	s.log.Infof("Loaded snapshot from %s", s.cfg.Persistence.Path)
+	s.setLoaded(true)
+	return nil
+}
+
+// Serve accepts connections on lis until the server is stopped. Health
+// checks are answered right away, cache calls only after Restore.
+func (s *Server) Serve(lis net.Listener) error {
+	go s.watchMemory(s.stop)
+	return s.grpc.Serve(lis)
+}
+
+// Draining reports whether the server is shutting down. A draining server
+// still finishes the calls it has accepted but should get no new traffic.
+func (s *Server) Draining() bool {
+	return atomic.LoadInt32(&s.draining) == 1
+}
+
+// Drain marks the server as draining without stopping it, so that load
+// balancers have time to notice before connections are closed.
+func (s *Server) Drain() {
+	if atomic.CompareAndSwapInt32(&s.draining, 0, 1) {
+		s.log.Infof("Draining")
+		s.health.Shutdown()
+	}
+}
+
+// Shutdown drains the server, waits for in-flight calls until ctx is done and
+// then flushes the cache to disk and closes it. Calls still running when ctx is done are
+// cancelled and ErrForcedStop is returned, unless flushing failed. A snapshot
+// still being written when ctx is done is abandoned and the engine left open.
+// Calling it again waits for the first call and returns its result.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.shutdown.Do(func() {
+		s.shutdownErr = s.stopAndFlush(ctx)
+	})
+	return s.shutdownErr
+}
+
+func (s *Server) stopAndFlush(ctx context.Context) error {
+	s.Drain()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(stopped)
+	}()
+	var stopErr error
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.log.Warnf("Shutdown deadline exceeded, cancelling in-flight calls")
+		s.grpc.Stop()
+		<-stopped
+		stopErr = ErrForcedStop
+	}
+	close(s.stop)
+
+	flushErr := s.Flush()
+	// a snapshot that timed out is still reading the engine, which can only
+	// be closed once it is done
+	if s.waitFlushes(ctx) {
+		if err := s.cache.Engine().Close(); err != nil {
+			s.log.Warnf("Error in closing the storage engine: %v", err)
+		}
+	} else {
+		s.log.Warnf("Shutdown deadline exceeded, abandoning a snapshot still being written and leaving the storage engine open")
+	}
+	if flushErr != nil {
+		return flushErr
+	}
+	return stopErr
+}
+
+// waitFlushes waits until no snapshot is being written and reports whether
+// that happened before ctx was done.
+func (s *Server) waitFlushes(ctx context.Context) bool {
+	if atomic.LoadInt32(&s.flushing) == 0 {
+		return true
+	}
+	done := make(chan struct{})
+	go func() {
+		s.flushes.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Flush writes a snapshot of the cache to the persistence path. The snapshot
+// is written to a temporary file first so a failed flush never corrupts the
+// previous one.
+func (s *Server) Flush() error {
+	if !s.cfg.Persistence.Enabled {
+		return nil
+	}
+	path := s.cfg.Persistence.Path
+	done := make(chan error, 1)
+	s.flushes.Add(1)
+	atomic.AddInt32(&s.flushing, 1)
+	go func() {
+		err := writeFile(path, s.cache)
+		// done before the result is sent, so a Flush that returned has
+		// nothing left running
+		atomic.AddInt32(&s.flushing, -1)
+		s.flushes.Done()
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-time.After(s.cfg.Persistence.Timeout):
+		return errors.New("snapshot timed out after " + s.cfg.Persistence.Timeout.String())
+	}
+	s.log.Infof("Saved snapshot to %s", path)
+	return nil
+}
+
+func writeFile(path string, cache *api.Cache) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := cache.Save(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/avag-sargsyan/stricache/cmd/stricache/config"
+	"github.com/avag-sargsyan/stricache/cmd/stricache/logger"
+	"github.com/avag-sargsyan/stricache/cmd/stricache/middleware"
+	"github.com/avag-sargsyan/stricache/proto/stricache"
+)
+
+func start(t *testing.T, cfg *config.Config) (*Server, *grpc.ClientConn) {
+	srv := New(cfg, logger.New(io.Discard, logger.Error, "text"))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(lis)
+	t.Cleanup(srv.grpc.Stop)
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return srv, conn
+}
+
+func checkHealth(t *testing.T, conn *grpc.ClientConn, service string, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != want {
+		t.Errorf("%q is %s, want %s", service, res.Status, want)
+	}
+}
+
+func TestHealthLifecycle(t *testing.T) {
+	cfg := config.Default()
+	cfg.Persistence.Enabled = true
+	cfg.Persistence.Path = t.TempDir() + "/snapshot"
+	srv, conn := start(t, cfg)
+	cache := stricache.NewStricacheServiceClient(conn)
+
+	checkHealth(t, conn, "", healthpb.HealthCheckResponse_NOT_SERVING)
+	checkHealth(t, conn, PersistenceHealth, healthpb.HealthCheckResponse_NOT_SERVING)
+	_, err := cache.AddString(context.Background(), &stricache.StringItem{Key: "k", Value: "v"})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("expected Unavailable while loading, got %v", err)
+	}
+
+	if err := srv.Restore(); err != nil {
+		t.Fatal(err)
+	}
+	checkHealth(t, conn, "", healthpb.HealthCheckResponse_SERVING)
+	checkHealth(t, conn, "stricache.StricacheService", healthpb.HealthCheckResponse_SERVING)
+	checkHealth(t, conn, MemoryHealth, healthpb.HealthCheckResponse_SERVING)
+	checkHealth(t, conn, ReplicationHealth, healthpb.HealthCheckResponse_SERVING)
+	if _, err := cache.AddString(context.Background(), &stricache.StringItem{Key: "k", Value: "v"}); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.Drain()
+	if !srv.Draining() {
+		t.Error("expected server to be draining")
+	}
+	checkHealth(t, conn, "", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	if err := srv.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// a signal and a deferred call can both shut down
+	if err := srv.Shutdown(context.Background()); err != nil {
+		t.Fatalf("second Shutdown = %v", err)
+	}
+
+	restored, _ := start(t, cfg)
+	if err := restored.Restore(); err != nil {
+		t.Fatal(err)
+	}
+	item, err := restored.cache.GetString(context.Background(), &stricache.GetKey{Key: "k"})
+	if err != nil || item.Value != "v" {
+		t.Errorf("snapshot not restored: %v, %v", item, err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Default()
+	cfg.RateLimit.Write = config.Rate{PerSecond: 1, Burst: 2}
+	cfg.Auth = config.Auth{Enabled: true, Tokens: []string{"first", "other"}}
+	srv, conn := start(t, cfg)
+	if err := srv.Restore(); err != nil {
+		t.Fatal(err)
+	}
+	cache := stricache.NewStricacheServiceClient(conn)
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer first")
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.AddString(ctx, &stricache.StringItem{Key: "k", Value: "v"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var trailer metadata.MD
+	_, err := cache.AddString(ctx, &stricache.StringItem{Key: "k", Value: "v"}, grpc.Trailer(&trailer))
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted past the burst, got %v", err)
+	}
+	if got := trailer.Get(middleware.RetryAfter); len(got) != 1 || got[0] != "1" {
+		t.Errorf("expected a retry-after of 1 second, got %v", got)
+	}
+	var retry *errdetails.RetryInfo
+	for _, d := range status.Convert(err).Details() {
+		if d, ok := d.(*errdetails.RetryInfo); ok {
+			retry = d
+		}
+	}
+	if d := retry.GetRetryDelay().AsDuration(); d <= 0 || d > time.Second {
+		t.Errorf("expected a RetryInfo of up to a second, got %v", retry)
+	}
+
+	// reads have their own, unlimited, rate
+	if _, err := cache.GetString(ctx, &stricache.GetKey{Key: "k"}); err != nil {
+		t.Errorf("expected reads to go through, got %v", err)
+	}
+	// and other clients their own buckets
+	other := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer other")
+	if _, err := cache.AddString(other, &stricache.StringItem{Key: "k", Value: "v"}); err != nil {
+		t.Errorf("expected another client's write to go through, got %v", err)
+	}
+}
@@ -0,0 +1,367 @@
+package engine
+
+import "encoding/binary"
+
+// An arena keeps the keys and values of a table in one byte slice, as
+// records linked in the order of the unnamed list, and finds them with an
+// open-addressing hash table of record offsets. Neither holds pointers, so
+// the garbage collector skips them however many keys there are, while it
+// has to visit every key and value of a map. Removed records stay in place
+// until they make up half of the arena, which is then compacted.
+
+// WithArena keeps the keys and values of the value types in arenas instead
+// of maps. Named lists and the other types stay on the Go heap.
+func WithArena() Option {
+	return func(c *Cache) {
+		c.arena = true
+	}
+}
+
+// A record starts with a header: links to the previous and the next record
+// as offsets plus one, 0 for none, the position in the unnamed list and the
+// lengths of the key and the value, which follow the header.
+const (
+	recPrev     = 0
+	recNext     = 8
+	recPos      = 16
+	recKeyLen   = 24
+	recValueLen = 28
+	recHeader   = 32
+)
+
+const (
+	// minCompact is the number of bytes of removed records an arena must
+	// hold before it is compacted.
+	minCompact = 64 << 10
+	minSlots   = 8
+)
+
+// arenaTable is the table of an arena. Records are referred to by their
+// offset plus one, so 0 can mean none.
+type arenaTable[T any] struct {
+	codec *Codec[T]
+	data  []byte
+	// the hash table, kept at most 3/4 full
+	slots []slot
+	n     int
+	// bytes of removed records
+	dead int
+	// first and last record of the list
+	head, tail uint64
+	changes    uint64
+}
+
+// slot is a slot of the hash table, free if ref is 0.
+type slot struct {
+	hash uint64
+	ref  uint64
+}
+
+func newArenaTable[T any](codec *Codec[T]) *arenaTable[T] {
+	return &arenaTable[T]{codec: codec, slots: make([]slot, minSlots)}
+}
+
+// hashKey is 64-bit FNV-1a with a final mix, as the hash table uses the low
+// bits.
+func hashKey[K string | []byte](key K) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= 1099511628211
+	}
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h
+}
+
+func (t *arenaTable[T]) link(ref uint64, field int) uint64 {
+	return binary.LittleEndian.Uint64(t.data[ref-1+uint64(field):])
+}
+
+func (t *arenaTable[T]) setLink(ref uint64, field int, to uint64) {
+	binary.LittleEndian.PutUint64(t.data[ref-1+uint64(field):], to)
+}
+
+func (t *arenaTable[T]) key(ref uint64) []byte {
+	o := ref - 1 + recHeader
+	return t.data[o : o+uint64(binary.LittleEndian.Uint32(t.data[ref-1+recKeyLen:]))]
+}
+
+func (t *arenaTable[T]) value(ref uint64) []byte {
+	o := ref - 1 + recHeader + uint64(binary.LittleEndian.Uint32(t.data[ref-1+recKeyLen:]))
+	return t.data[o : o+uint64(binary.LittleEndian.Uint32(t.data[ref-1+recValueLen:]))]
+}
+
+func (t *arenaTable[T]) size(ref uint64) int {
+	return recHeader + int(binary.LittleEndian.Uint32(t.data[ref-1+recKeyLen:])) +
+		int(binary.LittleEndian.Uint32(t.data[ref-1+recValueLen:]))
+}
+
+// find returns the slot of key, or the free slot it would go to, and
+// whether key is stored.
+func (t *arenaTable[T]) find(key string, h uint64) (int, bool) {
+	mask := uint64(len(t.slots) - 1)
+	for i := h & mask; ; i = (i + 1) & mask {
+		s := t.slots[i]
+		if s.ref == 0 {
+			return int(i), false
+		}
+		if s.hash == h && string(t.key(s.ref)) == key {
+			return int(i), true
+		}
+	}
+}
+
+// free empties slot i, moving later slots of the same probe sequence back
+// so that lookups don't stop early.
+func (t *arenaTable[T]) free(i int) {
+	mask := len(t.slots) - 1
+	for j := (i + 1) & mask; t.slots[j].ref != 0; j = (j + 1) & mask {
+		// the slot can move to i unless its home lies in (i, j]
+		home := int(t.slots[j].hash & uint64(mask))
+		if (j > i && (home <= i || home > j)) || (j < i && home <= i && home > j) {
+			t.slots[i] = t.slots[j]
+			i = j
+		}
+	}
+	t.slots[i] = slot{}
+}
+
+// insert puts a slot into slots, which must have a free one.
+func insert(slots []slot, s slot) {
+	mask := uint64(len(slots) - 1)
+	i := s.hash & mask
+	for slots[i].ref != 0 {
+		i = (i + 1) & mask
+	}
+	slots[i] = s
+}
+
+// grow doubles the hash table once it is 3/4 full.
+func (t *arenaTable[T]) grow() {
+	if t.n*4 <= len(t.slots)*3 {
+		return
+	}
+	slots := make([]slot, len(t.slots)*2)
+	for _, s := range t.slots {
+		if s.ref != 0 {
+			insert(slots, s)
+		}
+	}
+	t.slots = slots
+}
+
+// write appends a record and returns it.
+func (t *arenaTable[T]) write(key string, value T, pos int64) uint64 {
+	o := len(t.data)
+	var header [recHeader]byte
+	t.data = append(t.data, header[:]...)
+	t.data = append(t.data, key...)
+	t.data = t.codec.Append(t.data, value)
+	binary.LittleEndian.PutUint64(t.data[o+recPos:], uint64(pos))
+	binary.LittleEndian.PutUint32(t.data[o+recKeyLen:], uint32(len(key)))
+	binary.LittleEndian.PutUint32(t.data[o+recValueLen:], uint32(len(t.data)-o-recHeader-len(key)))
+	return uint64(o) + 1
+}
+
+// attach links a record in at the front or the back of the list.
+func (t *arenaTable[T]) attach(ref uint64, front bool) {
+	if front {
+		t.setLink(ref, recPrev, 0)
+		t.setLink(ref, recNext, t.head)
+		if t.head != 0 {
+			t.setLink(t.head, recPrev, ref)
+		} else {
+			t.tail = ref
+		}
+		t.head = ref
+		return
+	}
+	t.setLink(ref, recPrev, t.tail)
+	t.setLink(ref, recNext, 0)
+	if t.tail != 0 {
+		t.setLink(t.tail, recNext, ref)
+	} else {
+		t.head = ref
+	}
+	t.tail = ref
+}
+
+// detach unlinks a record from the list and counts it as removed.
+func (t *arenaTable[T]) detach(ref uint64) {
+	prev, next := t.link(ref, recPrev), t.link(ref, recNext)
+	if prev != 0 {
+		t.setLink(prev, recNext, next)
+	} else {
+		t.head = next
+	}
+	if next != 0 {
+		t.setLink(next, recPrev, prev)
+	} else {
+		t.tail = prev
+	}
+	t.dead += t.size(ref)
+}
+
+func (t *arenaTable[T]) get(key string) (T, bool, error) {
+	i, exists := t.find(key, hashKey(key))
+	if !exists {
+		var zero T
+		return zero, false, nil
+	}
+	return t.codec.Decode(t.value(t.slots[i].ref)), true, nil
+}
+
+func (t *arenaTable[T]) peek(key string) (T, error) {
+	value, _, err := t.get(key)
+	return value, err
+}
+
+func (t *arenaTable[T]) has(key string) bool {
+	_, exists := t.find(key, hashKey(key))
+	return exists
+}
+
+func (t *arenaTable[T]) put(key string, value T, pos int64, front bool) {
+	t.changes++
+	h := hashKey(key)
+	i, exists := t.find(key, h)
+	if exists {
+		t.detach(t.slots[i].ref)
+	} else {
+		t.n++
+	}
+	ref := t.write(key, value, pos)
+	t.attach(ref, front)
+	t.slots[i] = slot{h, ref}
+	t.grow()
+	t.compact()
+}
+
+// set writes a new record in place of the old one.
+func (t *arenaTable[T]) set(key string, value T) {
+	i, _ := t.find(key, hashKey(key))
+	old := t.slots[i].ref
+	ref := t.write(key, value, int64(t.link(old, recPos)))
+	prev, next := t.link(old, recPrev), t.link(old, recNext)
+	t.setLink(ref, recPrev, prev)
+	t.setLink(ref, recNext, next)
+	if prev != 0 {
+		t.setLink(prev, recNext, ref)
+	} else {
+		t.head = ref
+	}
+	if next != 0 {
+		t.setLink(next, recPrev, ref)
+	} else {
+		t.tail = ref
+	}
+	t.dead += t.size(old)
+	t.slots[i].ref = ref
+	t.compact()
+}
+
+func (t *arenaTable[T]) remove(key string) bool {
+	i, exists := t.find(key, hashKey(key))
+	if !exists {
+		return false
+	}
+	t.changes++
+	t.detach(t.slots[i].ref)
+	t.free(i)
+	t.n--
+	t.compact()
+	return true
+}
+
+// compact copies the live records to a new arena once removed records make
+// up half of the old one, and rebuilds the hash table for the new offsets.
+// Like the growth of a slice, the copy is paid for by the removals before
+// it.
+func (t *arenaTable[T]) compact() {
+	if t.n == 0 {
+		t.data, t.dead = nil, 0
+		return
+	}
+	if t.dead < minCompact || t.dead*2 < len(t.data) {
+		return
+	}
+	data := make([]byte, 0, len(t.data)-t.dead)
+	size := minSlots
+	for size < t.n*2 {
+		size *= 2
+	}
+	slots := make([]slot, size)
+	var prev uint64
+	for ref := t.head; ref != 0; ref = t.link(ref, recNext) {
+		moved := uint64(len(data)) + 1
+		data = append(data, t.data[ref-1:int(ref-1)+t.size(ref)]...)
+		binary.LittleEndian.PutUint64(data[moved-1+recPrev:], prev)
+		if prev != 0 {
+			binary.LittleEndian.PutUint64(data[prev-1+recNext:], moved)
+		} else {
+			t.head = moved
+		}
+		insert(slots, slot{hashKey(t.key(ref)), moved})
+		prev = moved
+	}
+	t.tail = prev
+	t.data, t.slots, t.dead = data, slots, 0
+}
+
+func (t *arenaTable[T]) len() int {
+	return t.n
+}
+
+// some returns the first key, as an arena has no cheap random choice.
+func (t *arenaTable[T]) some() (string, bool) {
+	if t.head == 0 {
+		return "", false
+	}
+	return string(t.key(t.head)), true
+}
+
+func (t *arenaTable[T]) edge(front bool) (int64, bool) {
+	ref := t.tail
+	if front {
+		ref = t.head
+	}
+	if ref == 0 {
+		return 0, false
+	}
+	return int64(t.link(ref, recPos)), true
+}
+
+func (t *arenaTable[T]) version() uint64 {
+	return t.changes
+}
+
+func (t *arenaTable[T]) iter(back bool) tableIter[T] {
+	it := &arenaIter[T]{t: t, ref: t.head, field: recNext}
+	if back {
+		it.ref, it.field = t.tail, recPrev
+	}
+	return it
+}
+
+type arenaIter[T any] struct {
+	t   *arenaTable[T]
+	ref uint64
+	// the link to follow
+	field int
+}
+
+func (it *arenaIter[T]) next() (string, T, int64, bool) {
+	if it.ref == 0 {
+		var zero T
+		return "", zero, 0, false
+	}
+	ref := it.ref
+	it.ref = it.t.link(ref, it.field)
+	return string(it.t.key(ref)), it.t.codec.Decode(it.t.value(ref)), int64(it.t.link(ref, recPos)), true
+}
+
+func (it *arenaIter[T]) err() error {
+	return nil
+}
@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// keysOf returns the keys and values of a table in list order.
+func keysOf[T any](t table[T], back bool) ([]string, []T) {
+	var keys []string
+	var values []T
+	it := t.iter(back)
+	for {
+		key, value, _, ok := it.next()
+		if !ok {
+			return keys, values
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+}
+
+func TestArenaMatchesMap(t *testing.T) {
+	matchesMap(t, newArenaTable(&stringCodec))
+}
+
+// matchesMap runs random operations on a and on a mapTable and fails if the
+// two ever differ.
+func matchesMap(t *testing.T, a table[string]) {
+	r := rand.New(rand.NewSource(1))
+	m := newMapTable[string]()
+	var pos int64
+	for i := 0; i < 100000; i++ {
+		// few keys, so most operations hit stored ones and removals pile up
+		key := fmt.Sprint("key", r.Intn(2000))
+		switch op := r.Intn(10); {
+		case op < 4:
+			front := op == 0
+			if front {
+				pos = -pos - 1
+			} else {
+				pos = -pos + 1
+			}
+			value := fmt.Sprint(i)
+			a.put(key, value, pos, front)
+			m.put(key, value, pos, front)
+		case op < 5:
+			if m.has(key) {
+				a.set(key, "set")
+				m.set(key, "set")
+			}
+		case op < 8:
+			if a.remove(key) != m.remove(key) {
+				t.Fatalf("remove(%q) differs", key)
+			}
+		default:
+			av, aok, err := a.get(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mv, mok, _ := m.get(key)
+			if av != mv || aok != mok {
+				t.Fatalf("get(%q) = %q, %v, want %q, %v", key, av, aok, mv, mok)
+			}
+		}
+		if a.has(key) != m.has(key) || a.len() != m.len() {
+			t.Fatalf("len is %d, want %d", a.len(), m.len())
+		}
+	}
+	for _, back := range []bool{false, true} {
+		ap, aok := a.edge(!back)
+		mp, mok := m.edge(!back)
+		if ap != mp || aok != mok {
+			t.Fatalf("edge(%v) = %d, %v, want %d, %v", !back, ap, aok, mp, mok)
+		}
+		ak, av := keysOf[string](a, back)
+		mk, mv := keysOf[string](m, back)
+		if !reflect.DeepEqual(ak, mk) || !reflect.DeepEqual(av, mv) {
+			t.Fatalf("table and map differ in order, back: %v", back)
+		}
+	}
+}
+
+func TestArenaCompacts(t *testing.T) {
+	a := newArenaTable(&bytesCodec)
+	value := make([]byte, 1000)
+	for i := 0; i < 1000; i++ {
+		a.put(fmt.Sprint(i), value, int64(i), false)
+	}
+	full := len(a.data)
+	for i := 0; i < 1000; i += 2 {
+		a.remove(fmt.Sprint(i))
+	}
+	if len(a.data) > full/2+minCompact || a.dead > len(a.data) {
+		t.Errorf("expected a compacted arena, got %d bytes of %d with %d removed", len(a.data), full, a.dead)
+	}
+	for i := 1; i < 1000; i += 2 {
+		if v, ok, _ := a.get(fmt.Sprint(i)); !ok || len(v) != 1000 {
+			t.Fatalf("key %d lost in compaction", i)
+		}
+	}
+	for i := 1; i < 1000; i += 2 {
+		a.remove(fmt.Sprint(i))
+	}
+	if a.data != nil || a.head != 0 || a.tail != 0 {
+		t.Error("expected an empty arena to release its data")
+	}
+}
@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"context"
+	"time"
+)
+
+var errNegativeTimeout = newError(InvalidArgument, "timeout must not be negative")
+
+// waiter is a blocked Shift or Pop call. Elements are handed to it directly
+// by the call that adds them, so a waiter can't lose an element to a
+// non-blocking call that arrives later.
+type waiter struct {
+	ch chan interface{}
+}
+
+// waitQueues holds blocked calls per list name in arrival order. The queues
+// of named lists are guarded by the lock of their shard, the queue of an
+// unnamed list by its own.
+type waitQueues map[string][]*waiter
+
+func (q waitQueues) add(list string) *waiter {
+	w := &waiter{make(chan interface{}, 1)}
+	q[list] = append(q[list], w)
+	return w
+}
+
+func (q waitQueues) remove(list string, w *waiter) {
+	ws := q[list]
+	for i := range ws {
+		if ws[i] == w {
+			ws = append(ws[:i], ws[i+1:]...)
+			break
+		}
+	}
+	if len(ws) == 0 {
+		delete(q, list)
+	} else {
+		q[list] = ws
+	}
+}
+
+// handOff gives item to the oldest call waiting on list and reports whether
+// there was one.
+func (q waitQueues) handOff(list string, item interface{}) bool {
+	ws := q[list]
+	if len(ws) == 0 {
+		return false
+	}
+	ws[0].ch <- item
+	if len(ws) == 1 {
+		delete(q, list)
+	} else {
+		q[list] = ws[1:]
+	}
+	return true
+}
+
+// addWaiter registers a call waiting on a list, locked by lockList, and
+// returns the function that removes the waiter again. queues returns the
+// wait queues of the type in a shard.
+func (c *Cache) addWaiter(name string, u *unnamedList, queues func(sh *shard) waitQueues) (*waiter, func()) {
+	if name == "" {
+		w := u.addWaiter()
+		return w, func() { u.removeWaiter(w) }
+	}
+	sh := c.shard(name)
+	w := queues(sh).add(name)
+	return w, func() {
+		sh.mu.Lock()
+		queues(sh).remove(name, w)
+		sh.mu.Unlock()
+	}
+}
+
+// wait blocks until w receives an element, the timeout passes or ctx is
+// done. A passed timeout returns nil and no error, a timeout of 0 waits for
+// ctx alone.
+func wait(ctx context.Context, w *waiter, remove func(), d time.Duration) (interface{}, error) {
+	var timeout <-chan time.Time
+	if d > 0 {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		timeout = t.C
+	}
+	var err error
+	select {
+	case item := <-w.ch:
+		return item, nil
+	case <-timeout:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	remove()
+	// an element may have been handed off before the waiter was removed
+	select {
+	case item := <-w.ch:
+		return item, nil
+	default:
+		return nil, err
+	}
+}
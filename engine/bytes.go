@@ -0,0 +1,79 @@
+package engine
+
+import "fmt"
+
+// maxBytesLen bounds the values SetRange and Append can grow unless
+// WithLimits sets another bound.
+const maxBytesLen = 512 << 20
+
+func (c *Cache) errBytesTooLong() error {
+	return &Error{InvalidArgument, fmt.Sprintf("value would exceed %d bytes", c.limits.MaxBytesLen), "VALUE_TOO_LARGE"}
+}
+
+// GetRange returns bytes start to stop of a value, both inclusive.
+func (c *Cache) GetRange(key string, start, stop int64) ([]byte, error) {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	value, exists, err := storeOf(c, Bytes).in(sh).table.get(key)
+	switch {
+	case err != nil:
+		return nil, err
+	case !exists:
+		return nil, ErrNoKey
+	}
+	from, to := span(start, stop, len(value))
+	return append([]byte(nil), value[from:to]...), nil
+}
+
+// SetRange overwrites part of a value, creating it if needed, and returns
+// the new length.
+func (c *Cache) SetRange(key string, offset int64, data []byte) (int, error) {
+	if offset < 0 {
+		return 0, errorf(InvalidArgument, "offset must not be negative, got %d", offset)
+	}
+	// offset+len(data) could overflow
+	if offset > int64(c.limits.MaxBytesLen)-int64(len(data)) {
+		return 0, c.errBytesTooLong()
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	old, _, err := storeOf(c, Bytes).in(sh).table.get(key)
+	if err != nil {
+		return 0, err
+	}
+	n := int(offset) + len(data)
+	if n < len(old) {
+		n = len(old)
+	}
+	// values may be shared with callers, so they are never changed in place
+	value := make([]byte, n)
+	copy(value, old)
+	copy(value[offset:], data)
+	if err := storeOf(c, Bytes).replace(sh, key, value); err != nil {
+		return 0, err
+	}
+	return len(value), nil
+}
+
+// Append adds bytes to the end of a value, creating it if needed, and
+// returns the new length.
+func (c *Cache) Append(key string, data []byte) (int, error) {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	old, _, err := storeOf(c, Bytes).in(sh).table.get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(old)+len(data) > c.limits.MaxBytesLen {
+		return 0, c.errBytesTooLong()
+	}
+	value := make([]byte, 0, len(old)+len(data))
+	value = append(append(value, old...), data...)
+	if err := storeOf(c, Bytes).replace(sh, key, value); err != nil {
+		return 0, err
+	}
+	return len(value), nil
+}
@@ -0,0 +1,117 @@
+// Package engine is the storage of stricache as a plain Go library. An
+// Engine holds strings, integers, floats and byte slices under keys and in
+// lists, as well as hashes, sets, sorted sets, documents and JSON
+// documents. Engines are safe for concurrent use.
+package engine
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Item is a key and its value. Elements of named lists have no key.
+type Item[T any] struct {
+	Key   string
+	Value T
+}
+
+// Cache is the Engine that keeps everything in memory, on the Go heap or,
+// with WithArena, partly in arenas the garbage collector doesn't scan. With
+// WithTiers, keys that aren't used move to disk.
+type Cache struct {
+	keys     keyCounts
+	stores   []store
+	shards   []*shard
+	eviction Eviction
+	// whether the value types are kept in arenas
+	arena bool
+	// the tiers of the value types, nil if they stay in memory
+	tiers  *tierState
+	limits Limits
+	// the usage of each tenant, nil without quotas
+	quotas *quotaState
+	// file descriptors added with RegisterTypes, dependencies first, and
+	// the types they define
+	typesMu sync.RWMutex
+	files   []*descriptorpb.FileDescriptorProto
+	types   *protoregistry.Files
+}
+
+// Eviction limits the number of keys each value type may hold.
+// MaxKeys of 0 means no limit.
+type Eviction struct {
+	Policy  string
+	MaxKeys int
+}
+
+const (
+	// EvictionNone rejects writes of new keys once MaxKeys is reached.
+	EvictionNone = "noeviction"
+	// EvictionRandom removes an arbitrary key to make room for a new one.
+	EvictionRandom = "random"
+)
+
+// Limits bound what a Cache grows by itself. Callers check the size of the
+// values they store.
+type Limits struct {
+	// MaxListLength is the number of elements a named list may hold, 0 for
+	// no limit.
+	MaxListLength int
+	// MaxBytesLen is the length SetRange and Append may grow a Bytes value
+	// to, 512 MiB if it is 0.
+	MaxBytesLen int
+	// MaxRandomCount is the number of members SRandMember may return when
+	// they can repeat, 65536 if it is 0.
+	MaxRandomCount int
+	// MaxDocumentSize is the size in bytes a document or the JSON encoding
+	// of a JSON document may grow to, 0 for no limit.
+	MaxDocumentSize int
+	// FiniteScores rejects infinite sorted set scores, including those an
+	// increment ends at. NaN scores are always rejected.
+	FiniteScores bool
+}
+
+type Option func(*Cache)
+
+// WithLimits bounds the length of named lists and Bytes values.
+func WithLimits(l Limits) Option {
+	return func(c *Cache) {
+		c.limits = l
+	}
+}
+
+// Limits implements Engine.
+func (c *Cache) Limits() Limits {
+	return c.limits
+}
+
+func WithEviction(e Eviction) Option {
+	return func(c *Cache) {
+		c.eviction = e
+	}
+}
+
+func New(opts ...Option) *Cache {
+	C := &Cache{
+		shards: make([]*shard, DefaultShards),
+		types:  &protoregistry.Files{},
+	}
+	for _, opt := range opts {
+		opt(C)
+	}
+	if C.limits.MaxBytesLen <= 0 {
+		C.limits.MaxBytesLen = maxBytesLen
+	}
+	if C.limits.MaxRandomCount <= 0 {
+		C.limits.MaxRandomCount = maxRandomCount
+	}
+	for _, t := range registered {
+		C.stores = append(C.stores, t.newStore(C))
+	}
+	for i := range C.shards {
+		C.shards[i] = C.newShard()
+	}
+	return C
+}
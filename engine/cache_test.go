@@ -0,0 +1,538 @@
+package engine_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/avag-sargsyan/stricache/engine"
+	"github.com/avag-sargsyan/stricache/engine/enginetest"
+)
+
+func TestEmbedded(t *testing.T) {
+	c := engine.New(engine.WithShards(4))
+	strings, ints := engine.Of(c, engine.String), engine.Of(c, engine.Int)
+
+	if err := strings.Add("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := strings.Unshift("b", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := strings.Get("a"); err != nil || v != "1" {
+		t.Fatalf("Get = %q, %v, want 1", v, err)
+	}
+	if _, err := strings.Get("missing"); err != engine.ErrNoKey {
+		t.Fatalf("Get of a missing key returned %v, want ErrNoKey", err)
+	}
+	items, err := strings.Shift("", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []engine.Item[string]{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}}; !reflect.DeepEqual(items, want) {
+		t.Fatalf("Shift = %v, want %v", items, want)
+	}
+
+	_, err = ints.Pop("", 1)
+	var e *engine.Error
+	if !errors.As(err, &e) || e.Kind != engine.FailedPrecondition {
+		t.Fatalf("Pop of an empty list returned %v, want a FailedPrecondition error", err)
+	}
+	if _, err := ints.ListIndex("missing", 0); !errors.As(err, &e) || e.Kind != engine.NotFound {
+		t.Fatalf("ListIndex of a missing list returned %v, want a NotFound error", err)
+	}
+
+	if n, err := c.ZAdd("z", []engine.ZMember{{Member: "x", Score: 2}, {Member: "y", Score: 1}}); err != nil || n != 2 {
+		t.Fatalf("ZAdd = %d, %v, want 2", n, err)
+	}
+	if got, want := c.ZRange("z", 0, -1, false), []engine.ZMember{{Member: "y", Score: 1}, {Member: "x", Score: 2}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZRange = %v, want %v", got, want)
+	}
+}
+
+func TestEmbeddedBlocking(t *testing.T) {
+	floats := engine.Of(engine.New(), engine.Float)
+
+	if _, ok, err := floats.BlockingShift(context.Background(), "q", 10*time.Millisecond); ok || err != nil {
+		t.Fatalf("BlockingShift on an empty list = %v, %v, want a timeout", ok, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := floats.BlockingShift(ctx, "q", 0); err != context.Canceled {
+		t.Fatalf("BlockingShift with a cancelled context returned %v, want context.Canceled", err)
+	}
+
+	done := make(chan engine.Item[float64])
+	go func() {
+		item, _, _ := floats.BlockingPop(context.Background(), "q", time.Second)
+		done <- item
+	}()
+	// the element is handed off or taken from the list, depending on which
+	// call comes first
+	if err := floats.Push("q", 1.5); err != nil {
+		t.Fatal(err)
+	}
+	if item := <-done; item.Value != 1.5 {
+		t.Fatalf("BlockingPop = %v, want 1.5", item.Value)
+	}
+}
+
+func TestEmbeddedSnapshot(t *testing.T) {
+	c := engine.New()
+	if _, err := c.HSet("h", map[string]string{"f": "v"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Of(c, engine.Bytes).Push("l", []byte{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	d := engine.New()
+	if err := d.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := d.HGet("h", "f"); err != nil || v != "v" {
+		t.Fatalf("HGet after Load = %q, %v, want v", v, err)
+	}
+	if got, want := d.Lists(), []engine.ListInfo{{Name: "l", Type: "bytes", Length: 1}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Lists after Load = %v, want %v", got, want)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	c := engine.New(engine.WithLimits(engine.Limits{MaxListLength: 2, MaxBytesLen: 4, MaxDocumentSize: 16, FiniteScores: true}))
+	ints := engine.Of(c, engine.Int)
+	ints.Push("l", 1)
+	ints.Push("l", 2)
+	var e *engine.Error
+	if err := ints.Push("l", 3); !errors.As(err, &e) || e.Kind != engine.InvalidArgument || e.Reason != "LIST_TOO_LONG" {
+		t.Fatalf("Push to a full list returned %v, want LIST_TOO_LONG", err)
+	}
+	if _, err := ints.ListInsert("l", 1, 3, false); !errors.As(err, &e) || e.Reason != "LIST_TOO_LONG" {
+		t.Fatalf("ListInsert into a full list returned %v, want LIST_TOO_LONG", err)
+	}
+	if n, _ := ints.ListLen("l"); n != 2 {
+		t.Fatalf("ListLen = %d, want 2", n)
+	}
+
+	if _, err := c.Append("b", []byte("abc")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Append("b", []byte("de")); !errors.As(err, &e) || e.Reason != "VALUE_TOO_LARGE" {
+		t.Fatalf("Append past the limit returned %v, want VALUE_TOO_LARGE", err)
+	}
+	if _, err := c.SetRange("b", 3, []byte("de")); !errors.As(err, &e) || e.Reason != "VALUE_TOO_LARGE" {
+		t.Fatalf("SetRange past the limit returned %v, want VALUE_TOO_LARGE", err)
+	}
+
+	if _, err := c.ZAdd("z", []engine.ZMember{{Member: "m", Score: math.Inf(-1)}}); !errors.As(err, &e) || e.Reason != "VALUE_NOT_FINITE" {
+		t.Fatalf("ZAdd of an infinite score returned %v, want VALUE_NOT_FINITE", err)
+	}
+	if _, err := c.ZIncrBy("z", "m", math.MaxFloat64); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ZIncrBy("z", "m", math.MaxFloat64); !errors.As(err, &e) || e.Reason != "VALUE_NOT_FINITE" {
+		t.Fatalf("ZIncrBy to an infinite score returned %v, want VALUE_NOT_FINITE", err)
+	}
+
+	if _, err := c.JSONSet("j", "$", "[]"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		_, err := c.JSONArrAppend("j", "$", `"12345"`)
+		if i > 0 && (!errors.As(err, &e) || e.Reason != "VALUE_TOO_LARGE") {
+			t.Fatalf("JSONArrAppend %d past the limit returned %v, want VALUE_TOO_LARGE", i, err)
+		}
+	}
+	if got, _ := c.JSONGet("j"); got[0].Values[0] != `["12345"]` {
+		t.Fatalf("JSONGet after appending past the limit = %v", got)
+	}
+}
+
+// point is a value type that isn't built in.
+type point struct {
+	X, Y int
+}
+
+var pointType = engine.Register("point", func(a, b point) bool { return a == b })
+
+func TestRegisteredType(t *testing.T) {
+	t.Run("Heap", func(t *testing.T) { testRegisteredType(t, engine.New(engine.WithShards(4))) })
+	t.Run("Arena", func(t *testing.T) { testRegisteredType(t, engine.New(engine.WithShards(4), engine.WithArena())) })
+	t.Run("Tiered", func(t *testing.T) { testRegisteredType(t, engine.New(engine.WithShards(4), tiny(t))) })
+}
+
+func testRegisteredType(t *testing.T, c *engine.Cache) {
+	points := engine.Of(c, pointType)
+	if err := points.Add("origin", point{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []point{{1, 2}, {3, 4}} {
+		if err := points.Push("path", p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := points.ListInsert("path", point{3, 4}, point{2, 3}, false); err != nil || n != 3 {
+		t.Fatalf("ListInsert = %d, %v, want 3", n, err)
+	}
+	// keys of different types are independent
+	if _, err := engine.Of(c, engine.String).Get("origin"); err != engine.ErrNoKey {
+		t.Fatalf("Get of a point key as a string returned %v, want ErrNoKey", err)
+	}
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d := engine.New()
+	if err := d.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	points = engine.Of(d, pointType)
+	if p, err := points.Get("origin"); err != nil || p != (point{}) {
+		t.Fatalf("Get after Load = %v, %v, want the origin", p, err)
+	}
+	if got, err := points.ListRange("path", 0, -1); err != nil || !reflect.DeepEqual(got, []point{{1, 2}, {2, 3}, {3, 4}}) {
+		t.Fatalf("ListRange after Load = %v, %v", got, err)
+	}
+	if got, want := d.Lists(), []engine.ListInfo{{Name: "path", Type: "point", Length: 3}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Lists after Load = %v, want %v", got, want)
+	}
+}
+
+func TestConformance(t *testing.T) {
+	enginetest.Run(t, func() engine.Engine { return engine.New(engine.WithShards(4)) })
+}
+
+func TestConformanceArena(t *testing.T) {
+	enginetest.Run(t, func() engine.Engine { return engine.New(engine.WithShards(4), engine.WithArena()) })
+}
+
+func TestConformanceTiered(t *testing.T) {
+	enginetest.Run(t, func() engine.Engine { return engine.New(engine.WithShards(4), tiny(t)) })
+}
+
+func TestConformanceQuotas(t *testing.T) {
+	quotas := engine.WithQuotas(engine.Quotas{Separator: ":", Default: engine.Quota{MaxKeys: 1 << 20}})
+	enginetest.Run(t, func() engine.Engine { return engine.New(engine.WithShards(4), quotas) })
+}
+
+// tiny returns tiers that keep hardly anything in memory.
+func tiny(t *testing.T) engine.Option {
+	return engine.WithTiers(engine.Tiers{Dir: t.TempDir(), Memory: 16, Disk: 1 << 30})
+}
+
+func TestQuotas(t *testing.T) {
+	quotas := engine.Quotas{
+		Separator: ":",
+		Default:   engine.Quota{MaxKeys: 2, MaxListLength: 1},
+		Tenants:   map[string]engine.Quota{"big": {MaxBytes: 10}},
+	}
+	c := engine.New(engine.WithShards(4), engine.WithQuotas(quotas))
+	strings, ints := engine.Of(c, engine.String), engine.Of(c, engine.Int)
+	var e *engine.Error
+
+	strings.Add("a:1", "x")
+	ints.Add("a:2", 1)
+	if err := strings.Add("a:3", "x"); !errors.As(err, &e) || e.Kind != engine.ResourceExhausted || e.Reason != "KEY_QUOTA_EXCEEDED" {
+		t.Fatalf("Add over the key quota returned %v, want KEY_QUOTA_EXCEEDED", err)
+	}
+	if err := strings.Add("a:1", "replaced"); err != nil {
+		t.Fatalf("replacing a key should not need another one, got %v", err)
+	}
+	if err := strings.Add("b:1", "x"); err != nil {
+		t.Fatalf("other tenants have their own quota, got %v", err)
+	}
+	if _, err := strings.Shift("", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := strings.Add("a:3", "x"); err != nil {
+		t.Fatalf("Shift should give the key back, got %v", err)
+	}
+
+	if _, err := c.Append("big:b", []byte("12345")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Append("big:b", []byte("6")); !errors.As(err, &e) || e.Reason != "BYTE_QUOTA_EXCEEDED" {
+		t.Fatalf("Append over the byte quota returned %v, want BYTE_QUOTA_EXCEEDED", err)
+	}
+	engine.Of(c, engine.Bytes).Delete("big:b")
+
+	if err := ints.Push("a:l", 1); !errors.As(err, &e) || e.Reason != "KEY_QUOTA_EXCEEDED" {
+		t.Fatalf("a named list should take a key, got %v", err)
+	}
+	ints.Push("c:l", 1)
+	if err := ints.Push("c:l", 2); !errors.As(err, &e) || e.Reason != "LIST_QUOTA_EXCEEDED" {
+		t.Fatalf("Push over the list quota returned %v, want LIST_QUOTA_EXCEEDED", err)
+	}
+
+	want := []engine.TenantUsage{
+		// "a:2" with 8 bytes of int, and "a:3" with "x"
+		{Tenant: "a", Keys: 2, Bytes: 15, Quota: quotas.Default},
+		{Tenant: "b", Keys: 1, Bytes: 4, Quota: quotas.Default},
+		{Tenant: "big", Quota: quotas.Tenants["big"]},
+		// "c:l" with an int
+		{Tenant: "c", Keys: 1, Bytes: 11, Quota: quotas.Default},
+	}
+	if got := c.Usage(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Usage = %+v, want %+v", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d := engine.New(engine.WithQuotas(quotas))
+	if err := d.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Usage(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Usage after Load = %+v, want %+v", got, want)
+	}
+	if engine.New().Usage() != nil {
+		t.Error("expected no usage without quotas")
+	}
+}
+
+// TestQuotasFullCache checks that a new key refused for a full cache gives
+// back the quota it took.
+func TestQuotasFullCache(t *testing.T) {
+	c := engine.New(
+		engine.WithQuotas(engine.Quotas{Separator: ":", Default: engine.Quota{MaxKeys: 10}}),
+		engine.WithEviction(engine.Eviction{Policy: engine.EvictionNone, MaxKeys: 1}),
+	)
+	calls := map[string]func(key string) error{
+		"HSet":    func(key string) error { _, err := c.HSet(key, map[string]string{"f": "v"}); return err },
+		"HIncrBy": func(key string) error { _, err := c.HIncrBy(key, "f", 1); return err },
+		"SAdd":    func(key string) error { _, err := c.SAdd(key, []string{"a"}); return err },
+		"ZAdd":    func(key string) error { _, err := c.ZAdd(key, []engine.ZMember{{Member: "a", Score: 1}}); return err },
+		"SetDocument": func(key string) error {
+			_, err := c.SetDocument(engine.Document{Key: key, TypeURL: "t"}, nil)
+			return err
+		},
+	}
+	// one key of each type fills the cache
+	for _, name := range []string{"HSet", "SAdd", "ZAdd", "SetDocument"} {
+		if err := calls[name]("t:1"); err != nil {
+			t.Fatalf("%s = %v", name, err)
+		}
+	}
+	want := c.Usage()
+	for name, call := range calls {
+		if err := call("t:2"); err != engine.ErrCacheFull {
+			t.Errorf("%s of a new key in a full cache returned %v, want ErrCacheFull", name, err)
+		}
+	}
+	if got := c.Usage(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Usage after refused keys = %+v, want %+v", got, want)
+	}
+}
+
+// TestEvictionEveryType fills each type without a Store past the eviction
+// limit, which evicts keys of the same type from other shards.
+func TestEvictionEveryType(t *testing.T) {
+	c := engine.New(engine.WithShards(8), engine.WithEviction(engine.Eviction{Policy: engine.EvictionRandom, MaxKeys: 4}))
+	for name, tc := range map[string]struct {
+		set    func(key string) error
+		exists func(key string) bool
+	}{
+		"hash": {
+			func(key string) error { _, err := c.HSet(key, map[string]string{"f": "v"}); return err },
+			func(key string) bool { return c.HLen(key) > 0 },
+		},
+		"set": {
+			func(key string) error { _, err := c.SAdd(key, []string{"a"}); return err },
+			func(key string) bool { return c.SCard(key) > 0 },
+		},
+		"sorted set": {
+			func(key string) error { _, err := c.ZAdd(key, []engine.ZMember{{Member: "a", Score: 1}}); return err },
+			func(key string) bool { return c.ZCard(key) > 0 },
+		},
+		"document": {
+			func(key string) error {
+				_, err := c.SetDocument(engine.Document{Key: key, TypeURL: "t"}, nil)
+				return err
+			},
+			func(key string) bool { _, err := c.GetDocument(key); return err == nil },
+		},
+		"json": {
+			func(key string) error { _, err := c.JSONSet(key, "$", "1"); return err },
+			func(key string) bool { _, err := c.JSONGet(key); return err == nil },
+		},
+	} {
+		stored := 0
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprint(name, i)
+			if err := tc.set(key); err != nil {
+				t.Fatalf("storing %s %s: %v", name, key, err)
+			}
+		}
+		for i := 0; i < 20; i++ {
+			if tc.exists(fmt.Sprint(name, i)) {
+				stored++
+			}
+		}
+		if stored != 4 {
+			t.Errorf("%d keys of type %s are stored, want 4", stored, name)
+		}
+	}
+}
+
+func TestQuotasCountEveryType(t *testing.T) {
+	quotas := engine.Quotas{Separator: ":", Default: engine.Quota{MaxBytes: 20}}
+	c := engine.New(engine.WithShards(4), engine.WithQuotas(quotas))
+	strings := engine.Of(c, engine.String)
+	var e *engine.Error
+
+	c.HSet("h:1", map[string]string{"f": "vv"})
+	c.SAdd("s:1", []string{"a", "b", "a"})
+	c.ZAdd("z:1", []engine.ZMember{{Member: "m", Score: 1}})
+	c.SetDocument(engine.Document{Key: "d:1", TypeURL: "t", Value: []byte("xy")}, nil)
+	c.JSONSet("j:1", "$", "[1]")
+	strings.Push("l:1", "abc")
+	usage := func(bytes ...int64) []engine.TenantUsage {
+		var res []engine.TenantUsage
+		for i, tenant := range []string{"d", "h", "j", "l", "s", "z"} {
+			if bytes[i] > 0 {
+				res = append(res, engine.TenantUsage{Tenant: tenant, Keys: 1, Bytes: bytes[i], Quota: quotas.Default})
+			}
+		}
+		if res == nil {
+			res = []engine.TenantUsage{}
+		}
+		return res
+	}
+	// every key counts its name and contents, a score 8 bytes
+	want := usage(6, 6, 6, 6, 5, 12)
+	if got := c.Usage(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Usage = %+v, want %+v", got, want)
+	}
+
+	large := `"` + string(bytes.Repeat([]byte("x"), 20)) + `"`
+	for name, call := range map[string]func() error{
+		"HSet":    func() error { _, err := c.HSet("h:1", map[string]string{"g": large}); return err },
+		"HIncrBy": func() error { _, err := c.HIncrBy("h:1", large, 1); return err },
+		"SAdd":    func() error { _, err := c.SAdd("s:1", []string{large}); return err },
+		"ZAdd":    func() error { _, err := c.ZAdd("z:1", []engine.ZMember{{Member: "n", Score: 2}}); return err },
+		"SetDocument": func() error {
+			_, err := c.SetDocument(engine.Document{Key: "d:1", TypeURL: "t", Value: []byte(large)}, nil)
+			return err
+		},
+		"JSONSet":       func() error { _, err := c.JSONSet("j:2", "$", large); return err },
+		"JSONArrAppend": func() error { _, err := c.JSONArrAppend("j:1", "$", large); return err },
+		"Push":          func() error { return strings.Push("l:1", large) },
+		"ListSet":       func() error { return strings.ListSet("l:1", 0, large) },
+	} {
+		if err := call(); !errors.As(err, &e) || e.Reason != "BYTE_QUOTA_EXCEEDED" {
+			t.Errorf("%s over the byte quota returned %v, want BYTE_QUOTA_EXCEEDED", name, err)
+		}
+	}
+	if got := c.Usage(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Usage after rejected writes = %+v, want %+v", got, want)
+	}
+	if doc, _ := c.JSONGet("j:1"); !reflect.DeepEqual(doc[0].Values, []string{"[1]"}) {
+		t.Errorf("expected a rejected JSONArrAppend to leave the document alone, got %v", doc)
+	}
+	if _, err := c.JSONGet("j:2"); err != engine.ErrNoKey {
+		t.Errorf("expected a rejected JSONSet not to create the document, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d := engine.New(engine.WithQuotas(quotas))
+	if err := d.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Usage(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Usage after Load = %+v, want %+v", got, want)
+	}
+
+	c.HDel("h:1", []string{"f"})
+	c.SRem("s:1", []string{"a"})
+	c.ZPopMin("z:1", 1)
+	c.DeleteDocument("d:1")
+	c.JSONDel("j:1", "$")
+	if got, want := c.Usage(), usage(0, 0, 0, 6, 4, 0); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Usage after removals = %+v, want %+v", got, want)
+	}
+	c.SPop("s:1", 1)
+	strings.Shift("l:1", 1)
+	if got := c.Usage(); len(got) != 0 {
+		t.Fatalf("expected nothing stored, got %+v", got)
+	}
+}
+
+// BenchmarkUnnamedIndex reads the unnamed list by index. With one dense shard
+// that is a direct lookup. Otherwise reads near the ends merge the shards
+// from the nearer end up to the index, and reads in the middle use the index
+// of the list, which stays valid as nothing is added or removed.
+func BenchmarkUnnamedIndex(b *testing.B) {
+	const n = 1 << 16
+	for _, shards := range []int{1, engine.DefaultShards} {
+		for _, at := range []int64{0, n / 100, n / 2} {
+			b.Run(fmt.Sprintf("shards=%d/index=%d", shards, at), func(b *testing.B) {
+				ints := engine.Of(engine.New(engine.WithShards(shards)), engine.Int)
+				for i := 0; i < n; i++ {
+					ints.Add(fmt.Sprint("k", i), int64(i))
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := ints.ListIndex("", at); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkHScan scans a large hash page by page. The field names are sorted
+// by the first page and kept for the later ones.
+func BenchmarkHScan(b *testing.B) {
+	c := engine.New()
+	fields := map[string]string{}
+	for i := 0; i < 1<<16; i++ {
+		fields[fmt.Sprint("f", i)] = "v"
+	}
+	c.HSet("h", fields)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for cursor := ""; ; {
+			next, _, err := c.HScan("h", cursor, "", 100)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+	}
+}
+
+// BenchmarkSRandMember samples a large set without sorting it.
+func BenchmarkSRandMember(b *testing.B) {
+	c := engine.New()
+	members := make([]string, 1<<16)
+	for i := range members {
+		members[i] = fmt.Sprint("m", i)
+	}
+	c.SAdd("s", members)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.SRandMember("s", 10); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
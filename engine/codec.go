@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"math"
+)
+
+// Codec turns values of a type into bytes and back, for arenas, which keep
+// values outside the Go heap. Decode gets the bytes Append appended and
+// must not keep them.
+type Codec[T any] struct {
+	Append func(b []byte, value T) []byte
+	Decode func(b []byte) T
+}
+
+var (
+	stringCodec = Codec[string]{
+		Append: func(b []byte, v string) []byte { return append(b, v...) },
+		Decode: func(b []byte) string { return string(b) },
+	}
+	intCodec = Codec[int64]{
+		Append: func(b []byte, v int64) []byte { return appendUint64(b, uint64(v)) },
+		Decode: func(b []byte) int64 { return int64(binary.LittleEndian.Uint64(b)) },
+	}
+	floatCodec = Codec[float64]{
+		Append: func(b []byte, v float64) []byte { return appendUint64(b, math.Float64bits(v)) },
+		Decode: func(b []byte) float64 { return math.Float64frombits(binary.LittleEndian.Uint64(b)) },
+	}
+	bytesCodec = Codec[[]byte]{
+		Append: func(b []byte, v []byte) []byte { return append(b, v...) },
+		Decode: func(b []byte) []byte { return append([]byte{}, b...) },
+	}
+)
+
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+// gobCodec returns a Codec that uses encoding/gob. Values of types
+// registered with Register must be encodable, so errors are bugs.
+func gobCodec[T any]() Codec[T] {
+	return Codec[T]{
+		Append: func(b []byte, v T) []byte {
+			buf := bytes.NewBuffer(b)
+			if err := gob.NewEncoder(buf).Encode(&v); err != nil {
+				panic("engine: encoding a value: " + err.Error())
+			}
+			return buf.Bytes()
+		},
+		Decode: func(b []byte) T {
+			var v T
+			if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v); err != nil {
+				panic("engine: decoding a value: " + err.Error())
+			}
+			return v
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package engine
+
+// chunkSize is the number of elements per deque chunk.
+const chunkSize = 128
+
+// minRing is the smallest number of chunk slots a deque keeps.
+const minRing = 4
+
+type chunk[T any] [chunkSize]T
+
+// deque is a double-ended queue stored in fixed-size chunks that are kept in
+// a ring. Pushing and popping at either end is O(1), indexing is O(1) and
+// inserting or removing in the middle moves the elements of the shorter side.
+// Popped slots are zeroed and at most one emptied chunk is kept for reuse,
+// so memory follows the length of the deque.
+type deque[T any] struct {
+	ring  []*chunk[T]
+	head  int // ring slot of the first chunk in use
+	used  int // chunks in use
+	off   int // position of the first element in the first chunk
+	n     int
+	spare *chunk[T]
+}
+
+func newDeque[T any](values ...T) *deque[T] {
+	d := &deque[T]{}
+	for _, v := range values {
+		d.PushBack(v)
+	}
+	return d
+}
+
+func (d *deque[T]) Len() int {
+	return d.n
+}
+
+func (d *deque[T]) at(i int) *T {
+	p := d.off + i
+	return &d.ring[(d.head+p/chunkSize)%len(d.ring)][p%chunkSize]
+}
+
+// At returns element i. i must be in [0, Len()).
+func (d *deque[T]) At(i int) T {
+	return *d.at(i)
+}
+
+// Set replaces element i. i must be in [0, Len()).
+func (d *deque[T]) Set(i int, v T) {
+	*d.at(i) = v
+}
+
+func (d *deque[T]) PushBack(v T) {
+	if d.off+d.n == d.used*chunkSize {
+		d.grow()
+		d.ring[(d.head+d.used)%len(d.ring)] = d.newChunk()
+		d.used++
+	}
+	*d.at(d.n) = v
+	d.n++
+}
+
+func (d *deque[T]) PushFront(v T) {
+	if d.off == 0 {
+		d.grow()
+		d.head = (d.head - 1 + len(d.ring)) % len(d.ring)
+		d.ring[d.head] = d.newChunk()
+		d.used++
+		d.off = chunkSize
+	}
+	d.off--
+	d.n++
+	*d.at(0) = v
+}
+
+// PopFront removes and returns the first element. The deque must not be empty.
+func (d *deque[T]) PopFront() T {
+	var zero T
+	p := d.at(0)
+	v := *p
+	*p = zero
+	d.off++
+	d.n--
+	if d.off == chunkSize || d.n == 0 {
+		d.release(d.head)
+		d.head = (d.head + 1) % len(d.ring)
+		d.used--
+		d.off = 0
+	}
+	d.shrink()
+	return v
+}
+
+// PopBack removes and returns the last element. The deque must not be empty.
+func (d *deque[T]) PopBack() T {
+	var zero T
+	p := d.at(d.n - 1)
+	v := *p
+	*p = zero
+	d.n--
+	if d.n == 0 || (d.off+d.n)%chunkSize == 0 {
+		d.release((d.head + d.used - 1) % len(d.ring))
+		d.used--
+	}
+	if d.n == 0 {
+		d.off = 0
+	}
+	d.shrink()
+	return v
+}
+
+// Insert puts v at position i, shifting later elements back. i must be in
+// [0, Len()].
+func (d *deque[T]) Insert(i int, v T) {
+	if i < d.n/2 {
+		var zero T
+		d.PushFront(zero)
+		for j := 0; j < i; j++ {
+			*d.at(j) = *d.at(j + 1)
+		}
+	} else {
+		var zero T
+		d.PushBack(zero)
+		for j := d.n - 1; j > i; j-- {
+			*d.at(j) = *d.at(j - 1)
+		}
+	}
+	*d.at(i) = v
+}
+
+// Remove deletes and returns element i. i must be in [0, Len()).
+func (d *deque[T]) Remove(i int) T {
+	v := *d.at(i)
+	if i < d.n/2 {
+		for j := i; j > 0; j-- {
+			*d.at(j) = *d.at(j - 1)
+		}
+		d.PopFront()
+	} else {
+		for j := i; j < d.n-1; j++ {
+			*d.at(j) = *d.at(j + 1)
+		}
+		d.PopBack()
+	}
+	return v
+}
+
+// Filter keeps the elements for which keep returns true, in order.
+func (d *deque[T]) Filter(keep func(i int, v T) bool) {
+	w := 0
+	for r := 0; r < d.n; r++ {
+		if v := *d.at(r); keep(r, v) {
+			*d.at(w) = v
+			w++
+		}
+	}
+	for d.n > w {
+		d.PopBack()
+	}
+}
+
+// Slice returns a copy of the elements in [start, stop).
+func (d *deque[T]) Slice(start, stop int) []T {
+	values := make([]T, 0, stop-start)
+	for i := start; i < stop; i++ {
+		values = append(values, *d.at(i))
+	}
+	return values
+}
+
+func (d *deque[T]) Values() []T {
+	return d.Slice(0, d.n)
+}
+
+// grow makes room in the ring for one more chunk.
+func (d *deque[T]) grow() {
+	if d.used < len(d.ring) {
+		return
+	}
+	size := 2 * len(d.ring)
+	if size < minRing {
+		size = minRing
+	}
+	d.resize(size)
+}
+
+// shrink halves the ring once it is mostly empty.
+func (d *deque[T]) shrink() {
+	if len(d.ring) > minRing && d.used < len(d.ring)/4 {
+		d.resize(len(d.ring) / 2)
+	}
+}
+
+func (d *deque[T]) resize(size int) {
+	ring := make([]*chunk[T], size)
+	for i := 0; i < d.used; i++ {
+		ring[i] = d.ring[(d.head+i)%len(d.ring)]
+	}
+	d.ring = ring
+	d.head = 0
+}
+
+func (d *deque[T]) newChunk() *chunk[T] {
+	if c := d.spare; c != nil {
+		d.spare = nil
+		return c
+	}
+	return new(chunk[T])
+}
+
+// release takes the chunk in the given ring slot out of use. Its elements
+// have already been zeroed.
+func (d *deque[T]) release(slot int) {
+	if d.spare == nil {
+		d.spare = d.ring[slot]
+	}
+	d.ring[slot] = nil
+}
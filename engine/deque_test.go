@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestDequeMatchesSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := newDeque[int]()
+	var want []int
+	for i := 0; i < 20000; i++ {
+		switch op := r.Intn(10); {
+		case op < 3:
+			d.PushBack(i)
+			want = append(want, i)
+		case op < 6:
+			d.PushFront(i)
+			want = append([]int{i}, want...)
+		case op < 7 && len(want) > 0:
+			if v := d.PopFront(); v != want[0] {
+				t.Fatalf("PopFront returned %d, want %d", v, want[0])
+			}
+			want = want[1:]
+		case op < 8 && len(want) > 0:
+			if v := d.PopBack(); v != want[len(want)-1] {
+				t.Fatalf("PopBack returned %d, want %d", v, want[len(want)-1])
+			}
+			want = want[:len(want)-1]
+		case op < 9:
+			at := r.Intn(len(want) + 1)
+			d.Insert(at, i)
+			want = append(want[:at], append([]int{i}, want[at:]...)...)
+		case len(want) > 0:
+			at := r.Intn(len(want))
+			if v := d.Remove(at); v != want[at] {
+				t.Fatalf("Remove returned %d, want %d", v, want[at])
+			}
+			want = append(want[:at], want[at+1:]...)
+		}
+		if d.Len() != len(want) {
+			t.Fatalf("Len is %d, want %d", d.Len(), len(want))
+		}
+	}
+	if fmt.Sprint(d.Values()) != fmt.Sprint(want) {
+		t.Fatal("deque and slice differ")
+	}
+	for i := range want {
+		if d.At(i) != want[i] {
+			t.Fatalf("At(%d) is %d, want %d", i, d.At(i), want[i])
+		}
+	}
+}
+
+func TestDequeReleasesMemory(t *testing.T) {
+	d := newDeque[string]()
+	for i := 0; i < 100*chunkSize; i++ {
+		d.PushBack("x")
+	}
+	for d.Len() > 1 {
+		d.PopFront()
+	}
+	if d.used != 1 || len(d.ring) > minRing {
+		t.Errorf("expected one chunk in a small ring, got %d chunks in %d slots", d.used, len(d.ring))
+	}
+	d.PopBack()
+	if d.used != 0 || d.spare == nil {
+		t.Errorf("expected the last chunk to be kept as spare, got %d chunks in use", d.used)
+	}
+}
+
+func TestDequeFilter(t *testing.T) {
+	d := newDeque(1, 2, 3, 4, 5, 6)
+	d.Filter(func(i int, v int) bool { return v%2 == 0 })
+	if fmt.Sprint(d.Values()) != "[2 4 6]" {
+		t.Errorf("unexpected values %v", d.Values())
+	}
+}
+
+// The benchmarks compare the deque with the slice operations the lists used
+// before: append to push, prepend by copying, reslice to shift.
+
+const benchSize = 100000
+
+func BenchmarkQueueSlice(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var list []string
+		for j := 0; j < benchSize; j++ {
+			list = append(list, "v")
+		}
+		for len(list) > 0 {
+			list = list[1:]
+		}
+	}
+}
+
+func BenchmarkQueueDeque(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		d := newDeque[string]()
+		for j := 0; j < benchSize; j++ {
+			d.PushBack("v")
+		}
+		for d.Len() > 0 {
+			d.PopFront()
+		}
+	}
+}
+
+func BenchmarkUnshiftSlice(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var list []string
+		for j := 0; j < benchSize/100; j++ {
+			list = append([]string{"v"}, list...)
+		}
+	}
+}
+
+func BenchmarkUnshiftDeque(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		d := newDeque[string]()
+		for j := 0; j < benchSize/100; j++ {
+			d.PushFront("v")
+		}
+	}
+}
+
+func BenchmarkIndexDeque(b *testing.B) {
+	d := newDeque[int]()
+	for j := 0; j < benchSize; j++ {
+		d.PushBack(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.At(i % benchSize)
+	}
+}
@@ -0,0 +1,279 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Document is a serialized message of any type, like google.protobuf.Any.
+type Document struct {
+	Key     string
+	TypeURL string
+	Value   []byte
+}
+
+// documentItem is a stored document.
+type documentItem struct {
+	TypeURL string
+	Value   []byte
+}
+
+// documentCache stores documents of any type. Documents are kept serialized
+// and only decoded for partial updates, which need to know their type.
+type documentCache struct {
+	keyed[documentItem]
+}
+
+// messageType returns the type behind a type URL, looking at the types
+// linked into the server first and at registered ones next.
+func (c *Cache) messageType(url string) (protoreflect.MessageType, error) {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByURL(url); err == nil {
+		return mt, nil
+	}
+	name := protoreflect.FullName(url[strings.LastIndex(url, "/")+1:])
+	c.typesMu.RLock()
+	d, err := c.types.FindDescriptorByName(name)
+	c.typesMu.RUnlock()
+	if err != nil {
+		return nil, errorf(FailedPrecondition, "unknown type %s, register it to use update masks", url)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errorf(FailedPrecondition, "%s is not a message", name)
+	}
+	return dynamicpb.NewMessageType(md), nil
+}
+
+// SetDocument stores a document, or updates the fields of the stored one
+// listed in paths, and returns the stored document.
+func (c *Cache) SetDocument(doc Document, paths []string) (Document, error) {
+	if doc.TypeURL == "" {
+		return Document{}, newError(InvalidArgument, "value with a type URL is required")
+	}
+	sh := c.shard(doc.Key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	item := documentItem{
+		TypeURL: doc.TypeURL,
+		Value:   append([]byte(nil), doc.Value...),
+	}
+	if len(paths) > 0 {
+		value, err := c.merge(sh, doc, paths)
+		if err != nil {
+			return Document{}, err
+		}
+		item.Value = value
+	}
+	if err := c.checkDocumentSize(len(item.Value), func() int { return len(sh.documents.items[doc.Key].Value) }); err != nil {
+		return Document{}, err
+	}
+	if err := sh.documents.charge(sh, doc.Key, item.size(doc.Key)); err != nil {
+		return Document{}, err
+	}
+	sh.documents.items[doc.Key] = item
+	return item.document(doc.Key), nil
+}
+
+func (c *Cache) GetDocument(key string) (Document, error) {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	item, exists := sh.documents.items[key]
+	if !exists {
+		return Document{}, ErrNoKey
+	}
+	return item.document(key), nil
+}
+
+func (c *Cache) DeleteDocument(key string) bool {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.documents.remove(key)
+}
+
+// ListDocuments returns the documents of a type whose keys start with
+// prefix, sorted by key. An empty type URL matches every type.
+func (c *Cache) ListDocuments(typeURL, prefix string) []Document {
+	var res []Document
+	unlock := c.rlockAll()
+	for _, sh := range c.shards {
+		for key, item := range sh.documents.items {
+			if (typeURL == "" || item.TypeURL == typeURL) && strings.HasPrefix(key, prefix) {
+				res = append(res, item.document(key))
+			}
+		}
+	}
+	unlock()
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res
+}
+
+// RegisterTypes makes the message types of a file descriptor set known for
+// update masks and returns the number of files that were new. The set must
+// contain the dependencies of its files, unless they are well-known types or
+// were registered before.
+func (c *Cache) RegisterTypes(set []*descriptorpb.FileDescriptorProto) (int, error) {
+	c.typesMu.Lock()
+	defer c.typesMu.Unlock()
+	files := c.files
+	for _, f := range set {
+		if _, err := (resolvers{c.types, protoregistry.GlobalFiles}).FindFileByPath(f.GetName()); err == nil {
+			continue
+		}
+		files = append(files, f)
+	}
+	types, err := buildTypes(files)
+	if err != nil {
+		return 0, newError(InvalidArgument, err.Error())
+	}
+	added := len(files) - len(c.files)
+	c.files, c.types = files, types
+	return added, nil
+}
+
+func (item documentItem) document(key string) Document {
+	return Document{key, item.TypeURL, append([]byte(nil), item.Value...)}
+}
+
+// checkDocumentSize rejects a document of size bytes past
+// Limits.MaxDocumentSize unless it is no larger than the oldSize it had
+// before, so documents stored before the limit was lowered may shrink.
+func (c *Cache) checkDocumentSize(size int, oldSize func() int) error {
+	if limit := c.limits.MaxDocumentSize; limit == 0 || size <= limit || size <= oldSize() {
+		return nil
+	}
+	return &Error{InvalidArgument, fmt.Sprintf("document would exceed %d bytes", c.limits.MaxDocumentSize), "VALUE_TOO_LARGE"}
+}
+
+// merge copies the fields listed in paths from doc to the document stored
+// under its key in sh, or to an empty one, and returns the result serialized.
+func (c *Cache) merge(sh *shard, doc Document, paths []string) ([]byte, error) {
+	mt, err := c.messageType(doc.TypeURL)
+	if err != nil {
+		return nil, err
+	}
+	dst, src := mt.New(), mt.New()
+	if old, exists := sh.documents.items[doc.Key]; exists {
+		if old.TypeURL != doc.TypeURL {
+			return nil, errorf(FailedPrecondition, "document %q is a %s", doc.Key, old.TypeURL)
+		}
+		if err := proto.Unmarshal(old.Value, dst.Interface()); err != nil {
+			return nil, errorf(DataLoss, "document %q: %v", doc.Key, err)
+		}
+	}
+	if err := proto.Unmarshal(doc.Value, src.Interface()); err != nil {
+		return nil, errorf(InvalidArgument, "value: %v", err)
+	}
+	if doc, ok := dst.Interface().(*structpb.Struct); ok {
+		for _, path := range paths {
+			mergeStruct(doc, src.Interface().(*structpb.Struct), strings.Split(path, "."))
+		}
+	} else {
+		for _, path := range paths {
+			if err := mergeField(dst, src, strings.Split(path, ".")); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return proto.Marshal(dst.Interface())
+}
+
+// mergeField copies the field at path from src to dst, clearing it in dst if
+// src doesn't set it. All but the last element of path must name singular
+// message fields.
+func mergeField(dst, src protoreflect.Message, path []string) error {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return errorf(InvalidArgument, "%s has no field %q", dst.Descriptor().FullName(), path[0])
+	}
+	if len(path) == 1 {
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+		return nil
+	}
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return errorf(InvalidArgument, "field %q is not a message", path[0])
+	}
+	return mergeField(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:])
+}
+
+// mergeStruct copies the value at path, a list of nested keys, from src to
+// dst, deleting it from dst if src doesn't have it.
+func mergeStruct(dst, src *structpb.Struct, path []string) {
+	if len(path) == 1 {
+		if v, ok := src.GetFields()[path[0]]; ok {
+			if dst.Fields == nil {
+				dst.Fields = map[string]*structpb.Value{}
+			}
+			dst.Fields[path[0]] = v
+		} else {
+			delete(dst.Fields, path[0])
+		}
+		return
+	}
+	next := src.GetFields()[path[0]].GetStructValue()
+	if next == nil {
+		next = &structpb.Struct{}
+	}
+	child := dst.GetFields()[path[0]].GetStructValue()
+	if child == nil {
+		child = &structpb.Struct{}
+		if dst.Fields == nil {
+			dst.Fields = map[string]*structpb.Value{}
+		}
+		dst.Fields[path[0]] = structpb.NewStructValue(child)
+	}
+	mergeStruct(child, next, path[1:])
+}
+
+// buildTypes links file descriptors, which must come after their
+// dependencies, against each other and the well-known types.
+func buildTypes(files []*descriptorpb.FileDescriptorProto) (*protoregistry.Files, error) {
+	types := &protoregistry.Files{}
+	for _, f := range files {
+		fd, err := protodesc.NewFile(f, resolvers{types, protoregistry.GlobalFiles})
+		if err != nil {
+			return nil, err
+		}
+		if err := types.RegisterFile(fd); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
+
+// resolvers looks up descriptors in each resolver in turn.
+type resolvers []protodesc.Resolver
+
+func (r resolvers) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	for _, res := range r {
+		if fd, err := res.FindFileByPath(path); err == nil {
+			return fd, nil
+		}
+	}
+	return nil, protoregistry.NotFound
+}
+
+func (r resolvers) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	for _, res := range r {
+		if d, err := res.FindDescriptorByName(name); err == nil {
+			return d, nil
+		}
+	}
+	return nil, protoregistry.NotFound
+}
@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Engine is the storage behind the service. Engines differ in where they
+// keep the data, not in what the calls do: every engine must pass the
+// tests of package enginetest. Cache keeps everything in Go maps and slices.
+type Engine interface {
+	// Store returns the store of the registered type with the given name,
+	// a Store[T] for the type's T. Of returns it typed.
+	Store(typeName string) interface{}
+	// Lists returns the named lists of every type.
+	Lists() []ListInfo
+
+	HSet(key string, fields map[string]string) (int, error)
+	HGet(key, field string) (string, error)
+	HMGet(key string, fields []string) []HashValue
+	HDel(key string, fields []string) int
+	HGetAll(key string) map[string]string
+	HKeys(key string) []string
+	HLen(key string) int
+	HIncrBy(key, field string, delta int64) (int64, error)
+	HScan(key, cursor, match string, count int) (string, []HashValue, error)
+	DeleteHash(key string) bool
+
+	SAdd(key string, add []string) (int, error)
+	SRem(key string, remove []string) int
+	SIsMember(key, member string) bool
+	SMembers(key string) []string
+	SCard(key string) int
+	SRandMember(key string, count int64) ([]string, error)
+	SPop(key string, count int64) ([]string, error)
+	SUnion(keys ...string) []string
+	SInter(keys ...string) []string
+	SDiff(keys ...string) []string
+	SUnionStore(destination string, keys ...string) (int, error)
+	SInterStore(destination string, keys ...string) (int, error)
+	SDiffStore(destination string, keys ...string) (int, error)
+	DeleteSet(key string) bool
+
+	ZAdd(key string, members []ZMember) (int, error)
+	ZIncrBy(key, member string, delta float64) (float64, error)
+	ZScore(key, member string) (float64, error)
+	ZRank(key, member string, reverse bool) (int, error)
+	ZRange(key string, start, stop int64, reverse bool) []ZMember
+	ZRangeByScore(key string, r ScoreRange) ([]ZMember, error)
+	ZRangeByLex(key string, r LexRange) ([]ZMember, error)
+	ZCount(key string, r ScoreRange) (int, error)
+	ZCard(key string) int
+	ZRem(key string, members []string) int
+	ZPopMin(key string, count int64) ([]ZMember, error)
+	ZPopMax(key string, count int64) ([]ZMember, error)
+	DeleteSortedSet(key string) bool
+
+	// byte ranges of Bytes values
+	GetRange(key string, start, stop int64) ([]byte, error)
+	SetRange(key string, offset int64, data []byte) (int, error)
+	Append(key string, data []byte) (int, error)
+
+	SetDocument(doc Document, paths []string) (Document, error)
+	GetDocument(key string) (Document, error)
+	DeleteDocument(key string) bool
+	ListDocuments(typeURL, prefix string) []Document
+	RegisterTypes(set []*descriptorpb.FileDescriptorProto) (int, error)
+
+	JSONSet(key, jsonPath, value string) (int, error)
+	JSONGet(key string, jsonPaths ...string) ([]JSONMatch, error)
+	JSONDel(key, jsonPath string) (int, error)
+	JSONArrAppend(key, jsonPath string, values ...string) ([]int, error)
+	JSONNumIncrBy(key, jsonPath string, delta float64) (JSONMatch, error)
+
+	// Save writes a snapshot of the whole engine to w.
+	Save(w io.Writer) error
+	// Load replaces the contents of the engine with a snapshot read from r.
+	Load(r io.Reader) error
+
+	// Limits returns the limits the engine enforces, with defaults filled
+	// in.
+	Limits() Limits
+	// Tiers describes the storage tiers of an engine that has them, from
+	// the fastest, and returns nil otherwise.
+	Tiers() []TierStats
+	// Usage returns what each tenant stores, and nil if the engine has no
+	// quotas.
+	Usage() []TenantUsage
+	// Close releases the resources of the engine, which must not be used
+	// afterwards.
+	Close() error
+}
+
+// Store holds the values of one type, under keys and in lists. Keys of
+// different types are independent of each other.
+type Store[T any] interface {
+	// Add stores value under key at the back of the unnamed list, moving a
+	// key that is already stored. A call waiting on the empty unnamed list
+	// takes the key and value instead.
+	Add(key string, value T) error
+	// Unshift is Add at the front of the unnamed list.
+	Unshift(key string, value T) error
+	Get(key string) (T, error)
+	// Delete removes key and reports whether it was stored.
+	Delete(key string) bool
+
+	// Shift removes count elements from the front of a list, 1 if count is
+	// 0. Elements of the unnamed list are keys, which are removed with them.
+	// The unnamed list always exists and ErrEmptyList, a FailedPrecondition
+	// error, is returned when it is empty. Named lists only exist while they
+	// hold elements, so taking from a named list that was never pushed to or
+	// was emptied is a NotFound error with reason LIST_NOT_FOUND.
+	Shift(list string, count int64) ([]Item[T], error)
+	// Pop is Shift at the back of a list.
+	Pop(list string, count int64) ([]Item[T], error)
+	// Push appends value to a named list, creating the list if needed. A
+	// call waiting on the empty list takes the value instead.
+	Push(list string, value T) error
+	// DeleteList removes a named list and reports whether it existed.
+	DeleteList(list string) (bool, error)
+	// BlockingShift takes the first element of a list like Shift, waiting
+	// up to timeout for one if the list is empty, or until ctx is done if
+	// timeout is 0. It reports false if the timeout passed and returns
+	// ctx.Err() if ctx was done first. Waiting calls are served in arrival
+	// order.
+	BlockingShift(ctx context.Context, list string, timeout time.Duration) (Item[T], bool, error)
+	// BlockingPop is BlockingShift at the back of a list.
+	BlockingPop(ctx context.Context, list string, timeout time.Duration) (Item[T], bool, error)
+	// Waiting returns the number of calls blocked on a list.
+	Waiting(list string) int
+
+	// ListRange returns the elements from start to stop, both included.
+	// Negative indices count from the end.
+	ListRange(list string, start, stop int64) ([]T, error)
+	ListIndex(list string, index int64) (T, error)
+	ListSet(list string, index int64, value T) error
+	// ListInsert inserts value before or after the first pivot and returns
+	// the new length of the list.
+	ListInsert(list string, pivot, value T, after bool) (int, error)
+	ListTrim(list string, start, stop int64) error
+	// ListRemove removes up to count elements equal to value, searching
+	// from the back if count is negative and removing all of them if it is
+	// 0. It returns how many were removed.
+	ListRemove(list string, count int64, value T) (int, error)
+	ListLen(list string) (int, error)
+}
+
+// Of returns the store of e for values of type t.
+func Of[T any](e Engine, t *Type[T]) Store[T] {
+	return e.Store(t.Name).(Store[T])
+}
+
+var _ Engine = (*Cache)(nil)
+
+// Store implements Engine. It returns nil for types that aren't registered.
+func (c *Cache) Store(typeName string) interface{} {
+	for i, t := range registered {
+		if t.name() == typeName {
+			return c.stores[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,702 @@
+// Package enginetest checks that an engine.Engine behaves like the engines
+// of package engine. Every engine must pass Run.
+package enginetest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/avag-sargsyan/stricache/engine"
+)
+
+// Run runs the conformance tests as subtests of t. newEngine must return a
+// new, empty engine on every call.
+func Run(t *testing.T, newEngine func() engine.Engine) {
+	t.Run("Values", func(t *testing.T) {
+		testValues(t, newEngine(), engine.String, [3]string{"a", "b", "c"})
+		testValues(t, newEngine(), engine.Int, [3]int64{1, -2, 3})
+		testValues(t, newEngine(), engine.Float, [3]float64{1.5, -2, 0})
+		testValues(t, newEngine(), engine.Bytes, [3][]byte{{1}, {2, 3}, {}})
+	})
+	t.Run("NamedLists", func(t *testing.T) {
+		testNamedLists(t, newEngine(), engine.String, [3]string{"a", "b", "c"})
+		testNamedLists(t, newEngine(), engine.Int, [3]int64{1, -2, 3})
+		testNamedLists(t, newEngine(), engine.Float, [3]float64{1.5, -2, 0})
+		testNamedLists(t, newEngine(), engine.Bytes, [3][]byte{{1}, {2, 3}, {4}})
+	})
+	t.Run("UnnamedOrder", func(t *testing.T) { testUnnamedOrder(t, newEngine()) })
+	t.Run("Blocking", func(t *testing.T) { testBlocking(t, newEngine()) })
+	t.Run("Hashes", func(t *testing.T) { testHashes(t, newEngine()) })
+	t.Run("HashScanWhileWriting", func(t *testing.T) { testHashScanWhileWriting(t, newEngine()) })
+	t.Run("Sets", func(t *testing.T) { testSets(t, newEngine()) })
+	t.Run("SortedSets", func(t *testing.T) { testSortedSets(t, newEngine()) })
+	t.Run("ByteRanges", func(t *testing.T) { testByteRanges(t, newEngine()) })
+	t.Run("Documents", func(t *testing.T) { testDocuments(t, newEngine()) })
+	t.Run("JSON", func(t *testing.T) { testJSON(t, newEngine()) })
+	t.Run("Snapshot", func(t *testing.T) { testSnapshot(t, newEngine(), newEngine()) })
+	t.Run("Concurrent", func(t *testing.T) { testConcurrent(t, newEngine()) })
+}
+
+// kind returns the kind of an engine error, or 0 for other errors.
+func kind(err error) engine.Kind {
+	var e *engine.Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return 0
+}
+
+// isErr reports whether err is an *engine.Error of kind with reason.
+func isErr(err error, kind engine.Kind, reason string) bool {
+	var e *engine.Error
+	return errors.As(err, &e) && e.Kind == kind && e.Reason == reason
+}
+
+func testValues[T any](t *testing.T, e engine.Engine, typ *engine.Type[T], v [3]T) {
+	s := engine.Of(e, typ)
+	if err := s.Add("k1", v[0]); err != nil {
+		t.Fatalf("%s: Add: %v", typ.Name, err)
+	}
+	if err := s.Add("k2", v[1]); err != nil {
+		t.Fatalf("%s: Add: %v", typ.Name, err)
+	}
+	if err := s.Unshift("k0", v[2]); err != nil {
+		t.Fatalf("%s: Unshift: %v", typ.Name, err)
+	}
+	if got, err := s.Get("k1"); err != nil || !reflect.DeepEqual(got, v[0]) {
+		t.Fatalf("%s: Get = %v, %v, want %v", typ.Name, got, err, v[0])
+	}
+	if _, err := s.Get("missing"); err != engine.ErrNoKey {
+		t.Fatalf("%s: Get of a missing key returned %v, want ErrNoKey", typ.Name, err)
+	}
+	// adding a stored key moves it to the back
+	if err := s.Add("k1", v[1]); err != nil {
+		t.Fatalf("%s: Add: %v", typ.Name, err)
+	}
+	if n, err := s.ListLen(""); err != nil || n != 3 {
+		t.Fatalf("%s: ListLen of the unnamed list = %d, %v, want 3", typ.Name, n, err)
+	}
+	if got, err := s.ListIndex("", -1); err != nil || !reflect.DeepEqual(got, v[1]) {
+		t.Fatalf("%s: ListIndex(-1) = %v, %v, want %v", typ.Name, got, err, v[1])
+	}
+	items, err := s.Shift("", 2)
+	if want := []engine.Item[T]{{Key: "k0", Value: v[2]}, {Key: "k2", Value: v[1]}}; err != nil || !reflect.DeepEqual(items, want) {
+		t.Fatalf("%s: Shift = %v, %v, want %v", typ.Name, items, err, want)
+	}
+	if _, err := s.Get("k0"); err != engine.ErrNoKey {
+		t.Fatalf("%s: Get of a shifted key returned %v, want ErrNoKey", typ.Name, err)
+	}
+	items, err = s.Pop("", 0)
+	if want := []engine.Item[T]{{Key: "k1", Value: v[1]}}; err != nil || !reflect.DeepEqual(items, want) {
+		t.Fatalf("%s: Pop = %v, %v, want %v", typ.Name, items, err, want)
+	}
+	// the unnamed list always exists, so taking from it when empty is a
+	// precondition failure
+	if _, err := s.Pop("", 1); !isErr(err, engine.FailedPrecondition, "LIST_EMPTY") {
+		t.Fatalf("%s: Pop of an empty list returned %v, want FailedPrecondition LIST_EMPTY", typ.Name, err)
+	}
+	if _, err := s.Shift("", 1); !isErr(err, engine.FailedPrecondition, "LIST_EMPTY") {
+		t.Fatalf("%s: Shift of an empty list returned %v, want FailedPrecondition LIST_EMPTY", typ.Name, err)
+	}
+	if _, err := s.Shift("", -1); kind(err) != engine.InvalidArgument {
+		t.Fatalf("%s: Shift with a negative count returned %v, want an InvalidArgument error", typ.Name, err)
+	}
+
+	if err := s.Add("k", v[0]); err != nil {
+		t.Fatalf("%s: Add: %v", typ.Name, err)
+	}
+	if !s.Delete("k") || s.Delete("missing") {
+		t.Fatalf("%s: Delete should report whether the key was stored", typ.Name)
+	}
+	if _, err := s.Get("k"); err != engine.ErrNoKey {
+		t.Fatalf("%s: Get of a deleted key returned %v, want ErrNoKey", typ.Name, err)
+	}
+	if n, err := s.ListLen(""); err != nil || n != 0 {
+		t.Fatalf("%s: ListLen after Delete = %d, %v, want 0", typ.Name, n, err)
+	}
+}
+
+func testNamedLists[T any](t *testing.T, e engine.Engine, typ *engine.Type[T], v [3]T) {
+	s := engine.Of(e, typ)
+	if err := s.Push("", v[0]); err != engine.ErrNoListName {
+		t.Fatalf("%s: Push without a list name returned %v, want ErrNoListName", typ.Name, err)
+	}
+	if _, err := s.ListRange("missing", 0, -1); kind(err) != engine.NotFound {
+		t.Fatalf("%s: ListRange of a missing list returned %v, want a NotFound error", typ.Name, err)
+	}
+	if _, err := s.Shift("missing", 1); !isErr(err, engine.NotFound, "LIST_NOT_FOUND") {
+		t.Fatalf("%s: Shift of a missing list returned %v, want NotFound LIST_NOT_FOUND", typ.Name, err)
+	}
+	if _, err := s.Pop("missing", 1); !isErr(err, engine.NotFound, "LIST_NOT_FOUND") {
+		t.Fatalf("%s: Pop of a missing list returned %v, want NotFound LIST_NOT_FOUND", typ.Name, err)
+	}
+	for _, value := range []T{v[0], v[1], v[0]} {
+		if err := s.Push("l", value); err != nil {
+			t.Fatalf("%s: Push: %v", typ.Name, err)
+		}
+	}
+	if got, want := e.Lists(), []engine.ListInfo{{Name: "l", Type: typ.Name, Length: 3}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("%s: Lists = %v, want %v", typ.Name, got, want)
+	}
+	if got, err := s.ListIndex("l", -2); err != nil || !reflect.DeepEqual(got, v[1]) {
+		t.Fatalf("%s: ListIndex(-2) = %v, %v, want %v", typ.Name, got, err, v[1])
+	}
+	if _, err := s.ListIndex("l", 3); kind(err) != engine.OutOfRange {
+		t.Fatalf("%s: ListIndex past the end returned %v, want an OutOfRange error", typ.Name, err)
+	}
+	if n, err := s.ListInsert("l", v[1], v[2], true); err != nil || n != 4 {
+		t.Fatalf("%s: ListInsert = %d, %v, want 4", typ.Name, n, err)
+	}
+	if _, err := s.ListInsert("l", v[2], v[2], false); err != nil {
+		t.Fatalf("%s: ListInsert: %v", typ.Name, err)
+	}
+	// v0 v1 v2 v2 v0
+	if n, err := s.ListRemove("l", -1, v[0]); err != nil || n != 1 {
+		t.Fatalf("%s: ListRemove = %d, %v, want 1", typ.Name, n, err)
+	}
+	if err := s.ListSet("l", 0, v[2]); err != nil {
+		t.Fatalf("%s: ListSet: %v", typ.Name, err)
+	}
+	// v2 v1 v2 v2
+	if got, err := s.ListRange("l", 1, -1); err != nil || !reflect.DeepEqual(got, []T{v[1], v[2], v[2]}) {
+		t.Fatalf("%s: ListRange = %v, %v", typ.Name, got, err)
+	}
+	if err := s.ListTrim("l", 0, 1); err != nil {
+		t.Fatalf("%s: ListTrim: %v", typ.Name, err)
+	}
+	items, err := s.Pop("l", 5)
+	if want := []engine.Item[T]{{Value: v[1]}, {Value: v[2]}}; err != nil || !reflect.DeepEqual(items, want) {
+		t.Fatalf("%s: Pop = %v, %v, want %v", typ.Name, items, err, want)
+	}
+	// an empty list no longer exists
+	if _, err := s.ListLen("l"); kind(err) != engine.NotFound {
+		t.Fatalf("%s: ListLen of an emptied list returned %v, want a NotFound error", typ.Name, err)
+	}
+	if _, err := s.Shift("l", 1); !isErr(err, engine.NotFound, "LIST_NOT_FOUND") {
+		t.Fatalf("%s: Shift of an emptied list returned %v, want NotFound LIST_NOT_FOUND", typ.Name, err)
+	}
+
+	if err := s.Push("d", v[0]); err != nil {
+		t.Fatalf("%s: Push: %v", typ.Name, err)
+	}
+	if removed, err := s.DeleteList("d"); err != nil || !removed {
+		t.Fatalf("%s: DeleteList = %v, %v, want true", typ.Name, removed, err)
+	}
+	if removed, err := s.DeleteList("d"); err != nil || removed {
+		t.Fatalf("%s: DeleteList of a missing list = %v, %v, want false", typ.Name, removed, err)
+	}
+	if got := e.Lists(); len(got) != 0 {
+		t.Fatalf("%s: Lists after DeleteList = %v, want none", typ.Name, got)
+	}
+}
+
+// testUnnamedOrder reads a long unnamed list by index and range, in the
+// middle and near the ends, as keys are added, moved and removed.
+func testUnnamedOrder(t *testing.T, e engine.Engine) {
+	s := engine.Of(e, engine.Int)
+	var want []int64
+	move := func(v int64, front bool) {
+		for i, w := range want {
+			if w == v {
+				want = append(want[:i], want[i+1:]...)
+				break
+			}
+		}
+		if front {
+			want = append([]int64{v}, want...)
+		} else {
+			want = append(want, v)
+		}
+	}
+	check := func(step string) {
+		t.Helper()
+		n := int64(len(want))
+		for _, at := range []int64{0, n / 10, n / 2, n - 1} {
+			if got, err := s.ListIndex("", at); err != nil || got != want[at] {
+				t.Fatalf("%s: ListIndex(%d) = %d, %v, want %d", step, at, got, err, want[at])
+			}
+		}
+		for _, r := range [][2]int64{{0, -1}, {n / 3, n / 2}, {n / 2, n - 5}, {n - 3, -1}} {
+			got, err := s.ListRange("", r[0], r[1])
+			stop := r[1]
+			if stop < 0 {
+				stop += n
+			}
+			if err != nil || !reflect.DeepEqual(got, want[r[0]:stop+1]) {
+				t.Fatalf("%s: ListRange(%d, %d) = %v, %v, want %v", step, r[0], r[1], got, err, want[r[0]:stop+1])
+			}
+		}
+	}
+	key := func(v int64) string { return fmt.Sprint("k", v) }
+
+	for v := int64(0); v < 200; v++ {
+		if err := s.Add(key(v), v); err != nil {
+			t.Fatal(err)
+		}
+		move(v, false)
+	}
+	check("Add")
+	check("reading again")
+	s.Add(key(10), 10)
+	move(10, false)
+	check("moving a key to the back")
+	s.Unshift(key(150), 150)
+	move(150, true)
+	check("moving a key to the front")
+	s.Delete(key(100))
+	move(100, false)
+	want = want[:len(want)-1]
+	check("Delete")
+	if _, err := s.Shift("", 3); err != nil {
+		t.Fatal(err)
+	}
+	want = want[3:]
+	check("Shift")
+}
+
+func testBlocking(t *testing.T, e engine.Engine) {
+	s := engine.Of(e, engine.Int)
+	if _, ok, err := s.BlockingShift(context.Background(), "q", 10*time.Millisecond); ok || err != nil {
+		t.Fatalf("BlockingShift on an empty list = %v, %v, want a timeout", ok, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := s.BlockingPop(ctx, "q", 0); err != context.Canceled {
+		t.Fatalf("BlockingPop with a cancelled context returned %v, want context.Canceled", err)
+	}
+
+	named := make(chan engine.Item[int64])
+	unnamed := make(chan engine.Item[int64])
+	go func() {
+		item, _, _ := s.BlockingShift(context.Background(), "q", time.Second)
+		named <- item
+	}()
+	go func() {
+		item, _, _ := s.BlockingPop(context.Background(), "", time.Second)
+		unnamed <- item
+	}()
+	// the elements are handed off or taken from the lists, depending on
+	// which calls come first
+	if err := s.Push("q", 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add("k", 8); err != nil {
+		t.Fatal(err)
+	}
+	if item := <-named; item != (engine.Item[int64]{Value: 7}) {
+		t.Fatalf("BlockingShift = %v, want 7", item)
+	}
+	if item := <-unnamed; item != (engine.Item[int64]{Key: "k", Value: 8}) {
+		t.Fatalf("BlockingPop = %v, want k: 8", item)
+	}
+	if _, err := s.Get("k"); err != engine.ErrNoKey {
+		t.Fatalf("Get of a taken key returned %v, want ErrNoKey", err)
+	}
+}
+
+func testHashes(t *testing.T, e engine.Engine) {
+	if n, err := e.HSet("h", map[string]string{"a": "1", "b": "2"}); err != nil || n != 2 {
+		t.Fatalf("HSet = %d, %v, want 2", n, err)
+	}
+	if v, err := e.HGet("h", "a"); err != nil || v != "1" {
+		t.Fatalf("HGet = %q, %v, want 1", v, err)
+	}
+	if _, err := e.HGet("h", "c"); err != engine.ErrNoField {
+		t.Fatalf("HGet of a missing field returned %v, want ErrNoField", err)
+	}
+	if _, err := e.HGet("missing", "a"); err != engine.ErrNoField {
+		t.Fatalf("HGet of a missing hash returned %v, want ErrNoField", err)
+	}
+	if n, err := e.HIncrBy("h", "b", 40); err != nil || n != 42 {
+		t.Fatalf("HIncrBy = %d, %v, want 42", n, err)
+	}
+	if _, err := e.HIncrBy("h", "x", 1); err != nil {
+		t.Fatalf("HIncrBy of a new field: %v", err)
+	}
+	got := e.HMGet("h", []string{"b", "c"})
+	if want := []engine.HashValue{{Field: "b", Value: "42", Found: true}, {Field: "c"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("HMGet = %v, want %v", got, want)
+	}
+	if keys := e.HKeys("h"); !sameStrings(keys, []string{"a", "b", "x"}) {
+		t.Fatalf("HKeys = %v", keys)
+	}
+	next, page, err := e.HScan("h", "", "", 2)
+	if err != nil || len(page) != 2 || next == "" {
+		t.Fatalf("HScan = %q, %v, %v, want a page of 2 and a cursor", next, page, err)
+	}
+	if next, rest, err := e.HScan("h", next, "", 2); err != nil || len(rest) != 1 || next != "" {
+		t.Fatalf("HScan = %q, %v, %v, want the last field", next, rest, err)
+	}
+	if n := e.HDel("h", []string{"a", "c"}); n != 1 {
+		t.Fatalf("HDel = %d, want 1", n)
+	}
+	if got := e.HGetAll("h"); !reflect.DeepEqual(got, map[string]string{"b": "42", "x": "1"}) {
+		t.Fatalf("HGetAll = %v", got)
+	}
+	if !e.DeleteHash("h") || e.DeleteHash("h") {
+		t.Fatal("DeleteHash should report whether the hash existed")
+	}
+	if n := e.HLen("h"); n != 0 {
+		t.Fatalf("HLen after DeleteHash = %d, want 0", n)
+	}
+}
+
+// testHashScanWhileWriting scans a hash while fields are added and removed
+// between pages, and checks that the scan returns the fields in order and
+// every field that was there throughout.
+func testHashScanWhileWriting(t *testing.T, e engine.Engine) {
+	fields := map[string]string{}
+	for i := 0; i < 100; i += 2 {
+		fields[fmt.Sprintf("f%03d", i)] = "v"
+	}
+	e.HSet("h", fields)
+	var seen []string
+	cursor := ""
+	for page := 0; ; page++ {
+		next, values, err := e.HScan("h", cursor, "", 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range values {
+			seen = append(seen, v.Field)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+		// one new field behind the cursor, one ahead of it, and one removed
+		// ahead of it
+		e.HSet("h", map[string]string{fmt.Sprintf("f%03d", page*14+1): "v", fmt.Sprintf("f%03d", page*14+13): "v"})
+		e.HDel("h", []string{fmt.Sprintf("f%03d", page*14+20)})
+	}
+	if !sort.StringsAreSorted(seen) {
+		t.Fatalf("HScan returned fields out of order: %v", seen)
+	}
+	for i := 0; i < 100; i += 2 {
+		field := fmt.Sprintf("f%03d", i)
+		if i%14 == 6 {
+			// removed before the scan reached it
+			continue
+		}
+		if j := sort.SearchStrings(seen, field); j == len(seen) || seen[j] != field {
+			t.Fatalf("HScan missed %s, which was never removed: %v", field, seen)
+		}
+	}
+	if keys := e.HKeys("h"); !sort.StringsAreSorted(keys) || len(keys) != e.HLen("h") {
+		t.Fatalf("HKeys = %v, want the %d fields in order", keys, e.HLen("h"))
+	}
+}
+
+func testSets(t *testing.T, e engine.Engine) {
+	if n, err := e.SAdd("s1", []string{"a", "b", "c", "a"}); err != nil || n != 3 {
+		t.Fatalf("SAdd = %d, %v, want 3", n, err)
+	}
+	if _, err := e.SAdd("s2", []string{"b", "c", "d"}); err != nil {
+		t.Fatal(err)
+	}
+	if !e.SIsMember("s1", "a") || e.SIsMember("s1", "d") {
+		t.Fatal("SIsMember doesn't match the members")
+	}
+	if got := e.SInter("s1", "s2"); !sameStrings(got, []string{"b", "c"}) {
+		t.Fatalf("SInter = %v", got)
+	}
+	if got := e.SUnion("s1", "s2", "missing"); !sameStrings(got, []string{"a", "b", "c", "d"}) {
+		t.Fatalf("SUnion = %v", got)
+	}
+	if got := e.SDiff("s1", "s2"); !sameStrings(got, []string{"a"}) {
+		t.Fatalf("SDiff = %v", got)
+	}
+	if n, err := e.SUnionStore("u", "s1", "s2"); err != nil || n != 4 || e.SCard("u") != 4 {
+		t.Fatalf("SUnionStore = %d, %v, want 4", n, err)
+	}
+	if n, err := e.SInterStore("u", "s1", "missing"); err != nil || n != 0 || e.SCard("u") != 0 {
+		t.Fatalf("SInterStore of an empty result = %d, %v, want the destination deleted", n, err)
+	}
+	if got, err := e.SRandMember("s1", 10); err != nil || !sameStrings(got, []string{"a", "b", "c"}) {
+		t.Fatalf("SRandMember with a count above the size = %v, %v, want every member once", got, err)
+	}
+	got, err := e.SRandMember("s1", -5)
+	if err != nil || len(got) != 5 {
+		t.Fatalf("SRandMember with a negative count returned %d members, %v, want 5", len(got), err)
+	}
+	for _, m := range got {
+		if !e.SIsMember("s1", m) {
+			t.Fatalf("SRandMember returned %q, which is not a member", m)
+		}
+	}
+	if _, err := e.SRandMember("s1", math.MinInt64); !isErr(err, engine.InvalidArgument, "COUNT_TOO_LARGE") {
+		t.Fatalf("SRandMember with count MinInt64 returned %v, want COUNT_TOO_LARGE", err)
+	}
+	popped, err := e.SPop("s1", 2)
+	if err != nil || len(popped) != 2 || e.SCard("s1") != 1 {
+		t.Fatalf("SPop = %v, %v, want 2 of 3 members", popped, err)
+	}
+	if popped[0] == popped[1] || e.SIsMember("s1", popped[0]) || e.SIsMember("s1", popped[1]) {
+		t.Fatalf("SPop = %v, want 2 distinct members that are removed", popped)
+	}
+	if n := e.SRem("s2", []string{"b", "x"}); n != 1 {
+		t.Fatalf("SRem = %d, want 1", n)
+	}
+	if !e.DeleteSet("s2") || e.DeleteSet("s2") {
+		t.Fatal("DeleteSet should report whether the set existed")
+	}
+	if got := e.SMembers("s2"); len(got) != 0 {
+		t.Fatalf("SMembers after DeleteSet = %v", got)
+	}
+}
+
+func testSortedSets(t *testing.T, e engine.Engine) {
+	members := []engine.ZMember{{Member: "a", Score: 1}, {Member: "b", Score: 2}, {Member: "c", Score: 3}}
+	if n, err := e.ZAdd("z", members); err != nil || n != 3 {
+		t.Fatalf("ZAdd = %d, %v, want 3", n, err)
+	}
+	if score, err := e.ZIncrBy("z", "a", 3.5); err != nil || score != 4.5 {
+		t.Fatalf("ZIncrBy = %v, %v, want 4.5", score, err)
+	}
+	if r, err := e.ZRank("z", "a", false); err != nil || r != 2 {
+		t.Fatalf("ZRank = %d, %v, want 2", r, err)
+	}
+	if _, err := e.ZScore("z", "x"); err != engine.ErrNoMember {
+		t.Fatalf("ZScore of a missing member returned %v, want ErrNoMember", err)
+	}
+	if got, want := e.ZRange("z", 0, 0, true), []engine.ZMember{{Member: "a", Score: 4.5}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZRange = %v, want %v", got, want)
+	}
+	got, err := e.ZRangeByScore("z", engine.ScoreRange{Min: 2, Max: 4.5, MaxExclusive: true})
+	if want := []engine.ZMember{{Member: "b", Score: 2}, {Member: "c", Score: 3}}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZRangeByScore = %v, %v, want %v", got, err, want)
+	}
+	if n, err := e.ZCount("z", engine.ScoreRange{Min: 3, Max: 10}); err != nil || n != 2 {
+		t.Fatalf("ZCount = %d, %v, want 2", n, err)
+	}
+	if _, err := e.ZAdd("lex", []engine.ZMember{{Member: "x"}, {Member: "y"}, {Member: "z"}}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = e.ZRangeByLex("lex", engine.LexRange{Min: "x", MinExclusive: true})
+	if want := []engine.ZMember{{Member: "y"}, {Member: "z"}}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZRangeByLex = %v, %v, want %v", got, err, want)
+	}
+	if got, err := e.ZPopMin("z", 1); err != nil || len(got) != 1 || got[0].Member != "b" {
+		t.Fatalf("ZPopMin = %v, %v, want b", got, err)
+	}
+	if got, err := e.ZPopMax("z", 1); err != nil || len(got) != 1 || got[0].Member != "a" {
+		t.Fatalf("ZPopMax = %v, %v, want a", got, err)
+	}
+	if n := e.ZRem("z", []string{"c", "x"}); n != 1 || e.ZCard("z") != 0 {
+		t.Fatalf("ZRem = %d, want the last member removed", n)
+	}
+	if !e.DeleteSortedSet("lex") || e.DeleteSortedSet("lex") {
+		t.Fatal("DeleteSortedSet should report whether the sorted set existed")
+	}
+	if n := e.ZCard("lex"); n != 0 {
+		t.Fatalf("ZCard after DeleteSortedSet = %d, want 0", n)
+	}
+}
+
+func testByteRanges(t *testing.T, e engine.Engine) {
+	s := engine.Of(e, engine.Bytes)
+	if n, err := e.Append("b", []byte("hello")); err != nil || n != 5 {
+		t.Fatalf("Append = %d, %v, want 5", n, err)
+	}
+	if n, err := e.SetRange("b", 7, []byte("!")); err != nil || n != 8 {
+		t.Fatalf("SetRange = %d, %v, want 8", n, err)
+	}
+	if got, err := s.Get("b"); err != nil || !bytes.Equal(got, []byte("hello\x00\x00!")) {
+		t.Fatalf("Get = %q, %v", got, err)
+	}
+	if got, err := e.GetRange("b", 1, -4); err != nil || string(got) != "ello" {
+		t.Fatalf("GetRange = %q, %v, want ello", got, err)
+	}
+	if _, err := e.GetRange("missing", 0, -1); err != engine.ErrNoKey {
+		t.Fatalf("GetRange of a missing key returned %v, want ErrNoKey", err)
+	}
+	// the end of the range doesn't fit in an int64
+	if _, err := e.SetRange("b", math.MaxInt64, []byte("!")); !isErr(err, engine.InvalidArgument, "VALUE_TOO_LARGE") {
+		t.Fatalf("SetRange at offset MaxInt64 returned %v, want VALUE_TOO_LARGE", err)
+	}
+}
+
+func testDocuments(t *testing.T, e engine.Engine) {
+	const typeURL = "type.googleapis.com/google.protobuf.StringValue"
+	value, err := proto.Marshal(wrapperspb.String("v1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"doc/a", "doc/b", "other"} {
+		if _, err := e.SetDocument(engine.Document{Key: key, TypeURL: typeURL, Value: value}, nil); err != nil {
+			t.Fatalf("SetDocument: %v", err)
+		}
+	}
+	update, err := proto.Marshal(wrapperspb.String("v2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.SetDocument(engine.Document{Key: "doc/a", TypeURL: typeURL, Value: update}, []string{"value"}); err != nil {
+		t.Fatalf("SetDocument with paths: %v", err)
+	}
+	doc, err := e.GetDocument("doc/a")
+	if err != nil || doc.TypeURL != typeURL {
+		t.Fatalf("GetDocument = %v, %v", doc, err)
+	}
+	var got wrapperspb.StringValue
+	if err := proto.Unmarshal(doc.Value, &got); err != nil || got.Value != "v2" {
+		t.Fatalf("GetDocument returned %q, %v, want v2", got.Value, err)
+	}
+	if docs := e.ListDocuments(typeURL, "doc/"); len(docs) != 2 {
+		t.Fatalf("ListDocuments returned %d documents, want 2", len(docs))
+	}
+	if !e.DeleteDocument("doc/a") || e.DeleteDocument("doc/a") {
+		t.Fatal("DeleteDocument should report whether the document existed")
+	}
+	if _, err := e.GetDocument("doc/a"); err != engine.ErrNoKey {
+		t.Fatalf("GetDocument of a deleted document returned %v, want ErrNoKey", err)
+	}
+}
+
+func testJSON(t *testing.T, e engine.Engine) {
+	if _, err := e.JSONSet("j", "$", `{"a":{"n":1},"l":[1]}`); err != nil {
+		t.Fatalf("JSONSet: %v", err)
+	}
+	if _, err := e.JSONSet("j", "$.a.s", `"x"`); err != nil {
+		t.Fatalf("JSONSet: %v", err)
+	}
+	if m, err := e.JSONNumIncrBy("j", "$.a.n", 2); err != nil || !reflect.DeepEqual(m.Values, []string{"3"}) {
+		t.Fatalf("JSONNumIncrBy = %v, %v, want 3", m, err)
+	}
+	if n, err := e.JSONArrAppend("j", "$.l", "2", "3"); err != nil || !reflect.DeepEqual(n, []int{3}) {
+		t.Fatalf("JSONArrAppend = %v, %v, want [3]", n, err)
+	}
+	// a failed call changes nothing
+	if _, err := e.JSONNumIncrBy("j", "$.a.*", 1); kind(err) == 0 {
+		t.Fatalf("JSONNumIncrBy of a string returned %v, want an engine error", err)
+	}
+	got, err := e.JSONGet("j", "$.a", "$.l[-1]")
+	want := []engine.JSONMatch{{Path: "$.a", Values: []string{`{"n":3,"s":"x"}`}}, {Path: "$.l[-1]", Values: []string{"3"}}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("JSONGet = %v, %v, want %v", got, err, want)
+	}
+	if n, err := e.JSONDel("j", "$"); err != nil || n != 1 {
+		t.Fatalf("JSONDel of the root = %d, %v, want 1", n, err)
+	}
+	if _, err := e.JSONGet("j", "$"); err != engine.ErrNoKey {
+		t.Fatalf("JSONGet of a deleted document returned %v, want ErrNoKey", err)
+	}
+}
+
+func testSnapshot(t *testing.T, e, restored engine.Engine) {
+	strings, floats := engine.Of(e, engine.String), engine.Of(e, engine.Float)
+	for i := 0; i < 10; i++ {
+		if err := strings.Add(fmt.Sprint("k", i), fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := floats.Push("l", 0.5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.HSet("h", map[string]string{"f": "v"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.SAdd("s", []string{"m"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.ZAdd("z", []engine.ZMember{{Member: "m", Score: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.JSONSet("j", "$", `[1,2]`); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := e.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// Load replaces what was there
+	if err := engine.Of(restored, engine.String).Add("gone", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if err := restored.Load(&buf); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	keys, err := engine.Of(restored, engine.String).Shift("", 20)
+	if err != nil || len(keys) != 10 || keys[0].Key != "k0" || keys[9].Key != "k9" {
+		t.Fatalf("keys after Load = %v, %v, want k0 to k9 in order", keys, err)
+	}
+	if got, want := restored.Lists(), []engine.ListInfo{{Name: "l", Type: engine.Float.Name, Length: 1}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Lists after Load = %v, want %v", got, want)
+	}
+	if v, err := restored.HGet("h", "f"); err != nil || v != "v" {
+		t.Fatalf("HGet after Load = %q, %v", v, err)
+	}
+	if !restored.SIsMember("s", "m") || restored.ZCard("z") != 1 {
+		t.Fatal("sets missing after Load")
+	}
+	if got, err := restored.JSONGet("j", "$"); err != nil || got[0].Values[0] != "[1,2]" {
+		t.Fatalf("JSONGet after Load = %v, %v", got, err)
+	}
+}
+
+func testConcurrent(t *testing.T, e engine.Engine) {
+	s := engine.Of(e, engine.Int)
+	const workers, keys = 8, 200
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < keys; i++ {
+				key := fmt.Sprint(w, "/", i)
+				if err := s.Add(key, int64(i)); err != nil {
+					t.Error(err)
+					return
+				}
+				if err := s.Push("l", int64(i)); err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := e.HIncrBy("h", "n", 1); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	if n, err := s.ListLen(""); err != nil || n != workers*keys {
+		t.Fatalf("ListLen of the unnamed list = %d, %v, want %d", n, err, workers*keys)
+	}
+	if n, err := s.ListLen("l"); err != nil || n != workers*keys {
+		t.Fatalf("ListLen = %d, %v, want %d", n, err, workers*keys)
+	}
+	if v, err := e.HGet("h", "n"); err != nil || v != fmt.Sprint(workers*keys) {
+		t.Fatalf("HGet = %q, %v, want %d", v, err, workers*keys)
+	}
+}
+
+// sameStrings reports whether a and b hold the same strings in any order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := map[string]int{}
+	for _, s := range a {
+		count[s]++
+	}
+	for _, s := range b {
+		count[s]--
+		if count[s] < 0 {
+			return false
+		}
+	}
+	return true
+}
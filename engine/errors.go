@@ -0,0 +1,72 @@
+package engine
+
+import "fmt"
+
+// Kind says what went wrong in a call that returned an *Error.
+type Kind int
+
+const (
+	// InvalidArgument means the arguments can't work whatever is stored.
+	InvalidArgument Kind = iota + 1
+	// NotFound means a key, field, member or named list doesn't exist.
+	NotFound
+	// FailedPrecondition means the arguments don't fit what is stored, like
+	// incrementing a field that isn't a number.
+	FailedPrecondition
+	// OutOfRange means an index or a result lies outside what is allowed.
+	OutOfRange
+	// DataLoss means stored data can't be decoded.
+	DataLoss
+	// Internal means stored data can't be encoded.
+	Internal
+	// ResourceExhausted means there is no room left for new keys.
+	ResourceExhausted
+)
+
+var kindNames = [...]string{
+	InvalidArgument:    "INVALID_ARGUMENT",
+	NotFound:           "NOT_FOUND",
+	FailedPrecondition: "FAILED_PRECONDITION",
+	OutOfRange:         "OUT_OF_RANGE",
+	DataLoss:           "DATA_LOSS",
+	Internal:           "INTERNAL",
+	ResourceExhausted:  "RESOURCE_EXHAUSTED",
+}
+
+// String returns the name of k in upper case, like NOT_FOUND.
+func (k Kind) String() string {
+	if k <= 0 || int(k) >= len(kindNames) {
+		return "UNKNOWN"
+	}
+	return kindNames[k]
+}
+
+// Error is an error of a known kind. Reason names the error for clients,
+// like KEY_NOT_FOUND, and is empty if the kind says enough.
+type Error struct {
+	Kind   Kind
+	Msg    string
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+func newError(kind Kind, msg string) error {
+	return &Error{Kind: kind, Msg: msg}
+}
+
+func errorf(kind Kind, format string, args ...interface{}) error {
+	return &Error{Kind: kind, Msg: fmt.Sprintf(format, args...)}
+}
+
+var (
+	ErrNoKey      error = &Error{NotFound, "No key found", "KEY_NOT_FOUND"}
+	ErrNoField    error = &Error{NotFound, "No field found", "FIELD_NOT_FOUND"}
+	ErrNoMember   error = &Error{NotFound, "No member found", "MEMBER_NOT_FOUND"}
+	ErrCacheFull  error = &Error{ResourceExhausted, "Cache is full", "CACHE_FULL"}
+	ErrNoListName error = &Error{InvalidArgument, "List name is required", "LIST_NAME_REQUIRED"}
+	ErrEmptyList  error = &Error{FailedPrecondition, "list is empty", "LIST_EMPTY"}
+	ErrIndex      error = &Error{OutOfRange, "index out of range", "INDEX_OUT_OF_RANGE"}
+)
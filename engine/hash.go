@@ -0,0 +1,297 @@
+package engine
+
+import (
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// defaultScanCount is the page size of a scan that doesn't ask for one.
+const defaultScanCount = 10
+
+// maxIndexedInserts is the number of new fields a write inserts into the
+// field names of a hash one by one. Writes of more drop the names instead.
+const maxIndexedInserts = 16
+
+// hash holds the fields of a hash. names are the field names in order, nil
+// until a scan needs them and kept up to date by writes from then on, so the
+// pages of a scan don't sort the hash again.
+type hash struct {
+	fields map[string]string
+	// mu guards building names, which scans do under a read lock
+	mu    sync.Mutex
+	names []string
+}
+
+func newHash(fields map[string]string) *hash {
+	return &hash{fields: fields}
+}
+
+// get returns the value of a field. h may be nil.
+func (h *hash) get(field string) (string, bool) {
+	if h == nil {
+		return "", false
+	}
+	value, exists := h.fields[field]
+	return value, exists
+}
+
+func (h *hash) len() int {
+	if h == nil {
+		return 0
+	}
+	return len(h.fields)
+}
+
+// set sets a field and reports whether it is new.
+func (h *hash) set(field, value string) bool {
+	_, exists := h.fields[field]
+	h.fields[field] = value
+	if !exists && h.names != nil {
+		i := sort.SearchStrings(h.names, field)
+		h.names = append(h.names, "")
+		copy(h.names[i+1:], h.names[i:])
+		h.names[i] = field
+	}
+	return !exists
+}
+
+// del removes a field and returns its value if it existed.
+func (h *hash) del(field string) (string, bool) {
+	value, exists := h.get(field)
+	if !exists {
+		return "", false
+	}
+	delete(h.fields, field)
+	if h.names != nil {
+		i := sort.SearchStrings(h.names, field)
+		h.names = append(h.names[:i], h.names[i+1:]...)
+	}
+	return value, true
+}
+
+// sorted returns the field names in order, which callers must not change.
+// h may be nil.
+func (h *hash) sorted() []string {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.names == nil {
+		h.names = make([]string, 0, len(h.fields))
+		for field := range h.fields {
+			h.names = append(h.names, field)
+		}
+		sort.Strings(h.names)
+	}
+	return h.names
+}
+
+// hashCache stores hashes. A hash exists while it has fields.
+type hashCache struct {
+	keyed[*hash]
+}
+
+// HashValue is a field of a hash. Found is false for a field that
+// doesn't exist.
+type HashValue struct {
+	Field string
+	Value string
+	Found bool
+}
+
+// HSet sets fields of a hash, creating it if needed, and returns the number
+// of fields that are new.
+func (c *Cache) HSet(key string, fields map[string]string) (int, error) {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	hash, exists := sh.hashes.items[key]
+	if !exists && len(fields) == 0 {
+		return 0, nil
+	}
+	var size int64
+	for field, value := range fields {
+		size += fieldSize(hash, field, value)
+	}
+	if err := sh.hashes.grow(sh, key, size); err != nil {
+		return 0, err
+	}
+	if !exists {
+		hash = newHash(map[string]string{})
+		sh.hashes.items[key] = hash
+	}
+	if len(fields) > maxIndexedInserts {
+		hash.names = nil
+	}
+	added := 0
+	for field, value := range fields {
+		if hash.set(field, value) {
+			added++
+		}
+	}
+	return added, nil
+}
+
+func (c *Cache) HGet(key, field string) (string, error) {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	value, exists := sh.hashes.items[key].get(field)
+	if !exists {
+		return "", ErrNoField
+	}
+	return value, nil
+}
+
+// HMGet returns the given fields in order. Missing fields are not found.
+func (c *Cache) HMGet(key string, fields []string) []HashValue {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	hash := sh.hashes.items[key]
+	res := make([]HashValue, 0, len(fields))
+	for _, field := range fields {
+		value, exists := hash.get(field)
+		res = append(res, HashValue{field, value, exists})
+	}
+	return res
+}
+
+// HDel removes fields of a hash and returns the number that existed.
+func (c *Cache) HDel(key string, fields []string) int {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	hash := sh.hashes.items[key]
+	removed := 0
+	var size int64
+	for _, field := range fields {
+		if value, exists := hash.del(field); exists {
+			removed++
+			size += int64(len(field) + len(value))
+		}
+	}
+	sh.hashes.charges.shrink(key, size)
+	if hash != nil && hash.len() == 0 {
+		sh.hashes.remove(key)
+	}
+	return removed
+}
+
+// HGetAll returns a copy of a hash, empty if it doesn't exist.
+func (c *Cache) HGetAll(key string) map[string]string {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	hash := sh.hashes.items[key]
+	fields := make(map[string]string, hash.len())
+	if hash != nil {
+		for field, value := range hash.fields {
+			fields[field] = value
+		}
+	}
+	return fields
+}
+
+// HKeys returns the field names of a hash in sorted order.
+func (c *Cache) HKeys(key string) []string {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return append([]string{}, sh.hashes.items[key].sorted()...)
+}
+
+func (c *Cache) HLen(key string) int {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.hashes.items[key].len()
+}
+
+// HIncrBy adds delta to an integer field, treating a missing field as 0,
+// and returns the new value.
+func (c *Cache) HIncrBy(key, field string, delta int64) (int64, error) {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	hash, exists := sh.hashes.items[key]
+	var current int64
+	if value, exists := hash.get(field); exists {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, errorf(FailedPrecondition, "field %q is not an integer", field)
+		}
+		current = n
+	}
+	if (delta > 0 && current > math.MaxInt64-delta) || (delta < 0 && current < math.MinInt64-delta) {
+		return 0, errorf(OutOfRange, "incrementing field %q overflows", field)
+	}
+	current += delta
+	value := strconv.FormatInt(current, 10)
+	if err := sh.hashes.grow(sh, key, fieldSize(hash, field, value)); err != nil {
+		return 0, err
+	}
+	if !exists {
+		hash = newHash(map[string]string{})
+		sh.hashes.items[key] = hash
+	}
+	hash.set(field, value)
+	return current, nil
+}
+
+// fieldSize returns the bytes setting field to value adds to hash, which
+// may be nil.
+func fieldSize(hash *hash, field, value string) int64 {
+	if old, exists := hash.get(field); exists {
+		return int64(len(value) - len(old))
+	}
+	return int64(len(field) + len(value))
+}
+
+// HScan returns up to count fields after cursor in field order, with the
+// cursor of the next page. The cursor is empty once the scan is done.
+func (c *Cache) HScan(key, cursor, match string, count int) (string, []HashValue, error) {
+	if match != "" {
+		if _, err := path.Match(match, ""); err != nil {
+			return "", nil, errorf(InvalidArgument, "bad match pattern %q", match)
+		}
+	}
+	if count <= 0 {
+		count = defaultScanCount
+	}
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	hash := sh.hashes.items[key]
+	fields := hash.sorted()
+	i := sort.SearchStrings(fields, cursor)
+	if i < len(fields) && fields[i] == cursor {
+		i++
+	}
+	var next string
+	var values []HashValue
+	for ; i < len(fields); i++ {
+		if match != "" {
+			if ok, _ := path.Match(match, fields[i]); !ok {
+				continue
+			}
+		}
+		if len(values) == count {
+			next = values[len(values)-1].Field
+			break
+		}
+		values = append(values, HashValue{fields[i], hash.fields[fields[i]], true})
+	}
+	return next, values, nil
+}
+
+func (c *Cache) DeleteHash(key string) bool {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.hashes.remove(key)
+}
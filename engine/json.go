@@ -0,0 +1,299 @@
+package engine
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// jsonCache stores decoded JSON documents. Numbers are kept as json.Number
+// so integers don't lose precision.
+type jsonCache struct {
+	keyed[interface{}]
+}
+
+// find returns the nodes path matches in the document under key.
+func (s *jsonCache) find(key string, path []segment, create bool) []jsonNode {
+	return find(
+		func() interface{} { return s.items[key] },
+		func(v interface{}) { s.items[key] = v },
+		func() { s.remove(key) },
+		path, create)
+}
+
+// update makes a change to the document under key, which must exist or have
+// been given room, and charges its new size. A change that takes it over its
+// quota or grows it past Limits.MaxDocumentSize is undone, removing a
+// document that is new.
+func (s *jsonCache) update(key string, change func()) error {
+	if s.charges.quotas == nil && s.c.limits.MaxDocumentSize == 0 {
+		change()
+		return nil
+	}
+	old, exists := s.items[key]
+	old = copyJSON(old)
+	change()
+	doc, stored := s.items[key]
+	if !stored {
+		return nil
+	}
+	size := jsonSize(key, doc)
+	err := s.c.checkDocumentSize(int(size)-len(key), func() int {
+		if !exists {
+			return 0
+		}
+		return int(jsonSize(key, old)) - len(key)
+	})
+	if err == nil {
+		err = s.charges.charge(key, size)
+	}
+	if err != nil {
+		if exists {
+			s.items[key] = old
+		} else {
+			s.remove(key)
+		}
+		return err
+	}
+	return nil
+}
+
+// JSONMatch holds the values a path matched, encoded as JSON.
+type JSONMatch struct {
+	Path   string
+	Values []string
+}
+
+// JSONSet replaces the values path matches and returns how many it did.
+// A missing object key at the end of the path is added. A new document can
+// only be set at the root.
+func (c *Cache) JSONSet(key, jsonPath, value string) (int, error) {
+	path, err := parseJSONPath(jsonPath)
+	if err != nil {
+		return 0, err
+	}
+	v, err := decodeJSON(value)
+	if err != nil {
+		return 0, err
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if _, exists := sh.json.items[key]; !exists {
+		if len(path) > 0 {
+			return 0, errorf(FailedPrecondition, "no document %q, set its root first", key)
+		}
+		if err := sh.json.makeRoom(sh, key); err != nil {
+			return 0, err
+		}
+	}
+	var nodes []jsonNode
+	if err := sh.json.update(key, func() {
+		nodes = sh.json.find(key, path, true)
+		for _, node := range nodes {
+			node.set(copyJSON(v))
+		}
+	}); err != nil {
+		return 0, err
+	}
+	return len(nodes), nil
+}
+
+// JSONGet returns the values each path matches, the whole document if no
+// path is given.
+func (c *Cache) JSONGet(key string, names ...string) ([]JSONMatch, error) {
+	if len(names) == 0 {
+		names = []string{"$"}
+	}
+	paths := make([][]segment, len(names))
+	for i, name := range names {
+		path, err := parseJSONPath(name)
+		if err != nil {
+			return nil, err
+		}
+		paths[i] = path
+	}
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	if _, exists := sh.json.items[key]; !exists {
+		return nil, ErrNoKey
+	}
+	res := make([]JSONMatch, 0, len(paths))
+	for i, path := range paths {
+		match := JSONMatch{Path: names[i]}
+		for _, node := range sh.json.find(key, path, false) {
+			b, err := json.Marshal(node.value)
+			if err != nil {
+				return nil, newError(Internal, err.Error())
+			}
+			match.Values = append(match.Values, string(b))
+		}
+		res = append(res, match)
+	}
+	return res, nil
+}
+
+// JSONDel removes the values path matches and returns how many it did.
+// Deleting the root deletes the document.
+func (c *Cache) JSONDel(key, jsonPath string) (int, error) {
+	path, err := parseJSONPath(jsonPath)
+	if err != nil {
+		return 0, err
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if _, exists := sh.json.items[key]; !exists {
+		return 0, nil
+	}
+	var nodes []jsonNode
+	// deleting never goes over a quota
+	sh.json.update(key, func() {
+		nodes = sh.json.find(key, path, false)
+		// later array elements go first so earlier indices stay valid
+		for i := len(nodes) - 1; i >= 0; i-- {
+			nodes[i].del()
+		}
+	})
+	return len(nodes), nil
+}
+
+// JSONArrAppend appends values to every array path matches and returns
+// their new lengths. Nothing changes if path matches anything but arrays.
+func (c *Cache) JSONArrAppend(key, jsonPath string, values ...string) ([]int, error) {
+	path, err := parseJSONPath(jsonPath)
+	if err != nil {
+		return nil, err
+	}
+	decoded := make([]interface{}, len(values))
+	for i, v := range values {
+		if decoded[i], err = decodeJSON(v); err != nil {
+			return nil, err
+		}
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if _, exists := sh.json.items[key]; !exists {
+		return nil, ErrNoKey
+	}
+	nodes := sh.json.find(key, path, false)
+	for _, node := range nodes {
+		if _, ok := node.value.([]interface{}); !ok {
+			return nil, errorf(FailedPrecondition, "path %q matches a value that is not an array", jsonPath)
+		}
+	}
+	res := make([]int, 0, len(nodes))
+	if err := sh.json.update(key, func() {
+		for _, node := range nodes {
+			list := node.value.([]interface{})
+			for _, v := range decoded {
+				list = append(list, copyJSON(v))
+			}
+			node.set(list)
+			res = append(res, len(list))
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// JSONNumIncrBy adds delta to every number path matches and returns the new
+// values. Integers stay integers if delta is a whole number. Nothing changes
+// if path matches anything but numbers.
+func (c *Cache) JSONNumIncrBy(key, jsonPath string, delta float64) (JSONMatch, error) {
+	path, err := parseJSONPath(jsonPath)
+	if err != nil {
+		return JSONMatch{}, err
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if _, exists := sh.json.items[key]; !exists {
+		return JSONMatch{}, ErrNoKey
+	}
+	nodes := sh.json.find(key, path, false)
+	sums := make([]json.Number, len(nodes))
+	for i, node := range nodes {
+		n, ok := node.value.(json.Number)
+		if !ok {
+			return JSONMatch{}, errorf(FailedPrecondition, "path %q matches a value that is not a number", jsonPath)
+		}
+		if sums[i], err = addNumber(n, delta); err != nil {
+			return JSONMatch{}, err
+		}
+	}
+	res := JSONMatch{Path: jsonPath}
+	if err := sh.json.update(key, func() {
+		for i, node := range nodes {
+			node.set(sums[i])
+			res.Values = append(res.Values, sums[i].String())
+		}
+	}); err != nil {
+		return JSONMatch{}, err
+	}
+	return res, nil
+}
+
+func addNumber(n json.Number, delta float64) (json.Number, error) {
+	if i, err := n.Int64(); err == nil && delta == math.Trunc(delta) && math.Abs(delta) < 1<<53 {
+		d := int64(delta)
+		if (d > 0 && i <= math.MaxInt64-d) || (d <= 0 && i >= math.MinInt64-d) {
+			return json.Number(strconv.FormatInt(i+d, 10)), nil
+		}
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return "", errorf(FailedPrecondition, "%s is out of range", n)
+	}
+	sum := f + delta
+	if math.IsInf(sum, 0) || math.IsNaN(sum) {
+		return "", newError(OutOfRange, "result is not a finite number")
+	}
+	return json.Number(strconv.FormatFloat(sum, 'g', -1, 64)), nil
+}
+
+func parseJSONPath(path string) ([]segment, error) {
+	segs, err := parsePath(path)
+	if err != nil {
+		return nil, newError(InvalidArgument, err.Error())
+	}
+	return segs, nil
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers as json.Number.
+func decodeJSON(s string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, errorf(InvalidArgument, "bad JSON: %v", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, newError(InvalidArgument, "bad JSON: data after the value")
+	}
+	return v, nil
+}
+
+// copyJSON returns a deep copy of a decoded JSON value.
+func copyJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = copyJSON(e)
+		}
+		return m
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, e := range v {
+			list[i] = copyJSON(e)
+		}
+		return list
+	}
+	return v
+}
@@ -0,0 +1,139 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// segment is one step of a JSON path: an object key, an array index or a
+// wildcard matching every child.
+type segment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// parsePath parses a JSONPath-like expression such as $.a.b[0], $['a'][*]
+// or a.*. The leading $ is optional and the empty path is the root.
+// Negative indices count from the end of an array.
+func parsePath(path string) ([]segment, error) {
+	p := strings.TrimPrefix(path, "$")
+	var segs []segment
+	for len(p) > 0 {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				end = len(p)
+			}
+			name := p[:end]
+			p = p[end:]
+			switch name {
+			case "":
+				return nil, fmt.Errorf("bad path %q: empty key", path)
+			case "*":
+				segs = append(segs, segment{wildcard: true})
+			default:
+				segs = append(segs, segment{key: name})
+			}
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if q := p[1:]; len(q) > 0 && (q[0] == '\'' || q[0] == '"') {
+				n := strings.IndexByte(q[1:], q[0])
+				if n < 0 || len(q) < n+3 || q[n+2] != ']' {
+					return nil, fmt.Errorf("bad path %q: unterminated key", path)
+				}
+				segs = append(segs, segment{key: q[1 : n+1]})
+				p = q[n+3:]
+				continue
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("bad path %q: missing ]", path)
+			}
+			inner := p[1:end]
+			p = p[end+1:]
+			if inner == "*" {
+				segs = append(segs, segment{wildcard: true})
+				continue
+			}
+			i, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("bad path %q: %q is not an index", path, inner)
+			}
+			segs = append(segs, segment{index: i, isIndex: true})
+		default:
+			if len(segs) == 0 && len(p) == len(path) {
+				// a path without $ may start with a bare key
+				p = "." + p
+				continue
+			}
+			return nil, fmt.Errorf("bad path %q: unexpected %q", path, p[0])
+		}
+	}
+	return segs, nil
+}
+
+// jsonNode is a value found by a path, with ways to replace or delete it in
+// its parent.
+type jsonNode struct {
+	value interface{}
+	set   func(v interface{})
+	del   func()
+}
+
+// find returns the nodes path matches in the value returned by get. With
+// create set, a missing object key in the last segment matches too, with
+// a nil value.
+func find(get func() interface{}, set func(interface{}), del func(), path []segment, create bool) []jsonNode {
+	v := get()
+	if len(path) == 0 {
+		return []jsonNode{{v, set, del}}
+	}
+	seg, rest := path[0], path[1:]
+	var nodes []jsonNode
+	switch parent := v.(type) {
+	case map[string]interface{}:
+		var keys []string
+		if seg.wildcard {
+			for k := range parent {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+		} else if _, exists := parent[seg.key]; exists || (create && len(rest) == 0 && !seg.isIndex) {
+			keys = []string{seg.key}
+		}
+		for _, k := range keys {
+			k := k
+			nodes = append(nodes, find(
+				func() interface{} { return parent[k] },
+				func(v interface{}) { parent[k] = v },
+				func() { delete(parent, k) },
+				rest, create)...)
+		}
+	case []interface{}:
+		var indices []int
+		if seg.wildcard {
+			for i := range parent {
+				indices = append(indices, i)
+			}
+		} else if i, ok := position(int64(seg.index), len(parent)); ok && seg.isIndex {
+			indices = []int{i}
+		}
+		for _, i := range indices {
+			i := i
+			nodes = append(nodes, find(
+				func() interface{} { return get().([]interface{})[i] },
+				func(v interface{}) { get().([]interface{})[i] = v },
+				func() {
+					list := get().([]interface{})
+					set(append(list[:i], list[i+1:]...))
+				},
+				rest, create)...)
+		}
+	}
+	return nodes
+}
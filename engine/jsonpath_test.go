@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	for path, want := range map[string]string{
+		"":               "[]",
+		"$":              "[]",
+		"$.a.b":          "[{a 0 false false} {b 0 false false}]",
+		"a[0]":           "[{a 0 false false} { 0 true false}]",
+		"$['x.y'][-1]":   "[{x.y 0 false false} { -1 true false}]",
+		`$["a"].*[*]`:    "[{a 0 false false} { 0 false true} { 0 false true}]",
+		"$.list[2].name": "[{list 0 false false} { 2 true false} {name 0 false false}]",
+		"$..a":           "error",
+		"$.a[x]":         "error",
+		"$['a'":          "error",
+		"$[1":            "error",
+	} {
+		segs, err := parsePath(path)
+		got := fmt.Sprint(segs)
+		if err != nil {
+			got = "error"
+		}
+		if got != want {
+			t.Errorf("parsePath(%q) = %s, want %s", path, got, want)
+		}
+	}
+}
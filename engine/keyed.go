@@ -0,0 +1,71 @@
+package engine
+
+import "sync/atomic"
+
+// keyed is the part of a type without a Store that belongs to a shard: its
+// keys with their values, counted for the eviction limit and charged to the
+// quotas.
+type keyed[V any] struct {
+	items   map[string]V
+	keys    *int64
+	charges charges
+	c       *Cache
+	// in returns the keyed of the same type in a shard, to evict from
+	in func(sh *shard) *keyed[V]
+}
+
+func newKeyed[V any](c *Cache, keys *int64, in func(sh *shard) *keyed[V]) keyed[V] {
+	return keyed[V]{map[string]V{}, keys, newCharges(c.quotas), c, in}
+}
+
+// remove deletes the value stored under key.
+func (s *keyed[V]) remove(key string) bool {
+	if _, exists := s.items[key]; !exists {
+		return false
+	}
+	delete(s.items, key)
+	atomic.AddInt64(s.keys, -1)
+	s.charges.forget(key)
+	return true
+}
+
+// evict removes an arbitrary key and reports whether there was one.
+func (s *keyed[V]) evict() bool {
+	for k := range s.items {
+		s.remove(k)
+		return true
+	}
+	return false
+}
+
+// makeRoom makes sure key can be stored in sh without exceeding the
+// eviction limit.
+func (s *keyed[V]) makeRoom(sh *shard, key string) error {
+	if _, exists := s.items[key]; exists {
+		return nil
+	}
+	return s.c.reserve(sh, s.keys, func(sh *shard) bool { return s.in(sh).evict() })
+}
+
+// grow charges key bytes more and makes room for it, undoing the charge of
+// a new key that doesn't fit. sh is the locked shard of key.
+func (s *keyed[V]) grow(sh *shard, key string, bytes int64) error {
+	return s.claim(sh, key, func() error { return s.charges.grow(key, bytes) })
+}
+
+// charge is grow for a key that takes size bytes in all.
+func (s *keyed[V]) charge(sh *shard, key string, size int64) error {
+	return s.claim(sh, key, func() error { return s.charges.charge(key, size) })
+}
+
+func (s *keyed[V]) claim(sh *shard, key string, charge func() error) error {
+	if err := charge(); err != nil {
+		return err
+	}
+	if err := s.makeRoom(sh, key); err != nil {
+		// only new keys need room
+		s.charges.forget(key)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package engine
+
+// Reads work on named lists and on the unnamed list. Writes need a list
+// name, the unnamed list only changes through the key/value calls.
+
+var errNoPivot = &Error{NotFound, "pivot not found", "PIVOT_NOT_FOUND"}
+
+// position converts an index that may count from the end into a position in
+// a list of length n.
+func position(i int64, n int) (int, bool) {
+	if i < 0 {
+		i += int64(n)
+	}
+	if i < 0 || i >= int64(n) {
+		return 0, false
+	}
+	return int(i), true
+}
+
+// span converts inclusive start and stop indices that may count from the
+// end into a half-open range within a list of length n.
+func span(start, stop int64, n int) (int, int) {
+	if start < 0 {
+		start += int64(n)
+	}
+	if stop < 0 {
+		stop += int64(n)
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= int64(n) {
+		stop = int64(n) - 1
+	}
+	if start > stop {
+		return 0, 0
+	}
+	return int(start), int(stop) + 1
+}
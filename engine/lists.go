@@ -0,0 +1,44 @@
+package engine
+
+import "sort"
+
+// popCount returns how many elements a pop asks for, 1 if count is 0.
+func popCount(count int64) (int, error) {
+	switch {
+	case count < 0:
+		return 0, errorf(InvalidArgument, "count must not be negative, got %d", count)
+	case count == 0:
+		return 1, nil
+	}
+	return int(count), nil
+}
+
+// ListInfo describes a named list.
+type ListInfo struct {
+	Name string
+	// name of the value type
+	Type   string
+	Length int
+}
+
+// Named lists are independent of the key/value maps and of the unnamed list
+// that Add and Unshift append to. A list exists while it holds elements.
+
+// Lists returns every named list of every type, sorted by type in
+// registration order and then by name.
+func (c *Cache) Lists() []ListInfo {
+	var res []ListInfo
+	unlock := c.rlockAll()
+	for _, s := range c.stores {
+		var lists []ListInfo
+		for _, sh := range c.shards {
+			lists = append(lists, s.lists(sh)...)
+		}
+		sort.Slice(lists, func(i, j int) bool {
+			return lists[i].Name < lists[j].Name
+		})
+		res = append(res, lists...)
+	}
+	unlock()
+	return res
+}
@@ -0,0 +1,343 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// With quotas, keys of every type and named lists belong to the tenant their
+// name starts with. A tenant's usage is the number of its keys and named
+// lists and the bytes of their names and contents, changed as they are
+// stored, changed and removed, and rebuilt by Load. Writes that would take
+// a tenant over its quota fail without changing anything; writes that only
+// shrink what it stores never do.
+
+// Quota bounds what a tenant may store. Zero means no limit.
+type Quota struct {
+	MaxKeys  int64
+	MaxBytes int64
+	// MaxListLength is the number of elements of each named list.
+	MaxListLength int
+}
+
+// Quotas configures WithQuotas.
+type Quotas struct {
+	// Separator ends the tenant of a key or list name. Names without it
+	// belong to the tenant "".
+	Separator string
+	// Default is the quota of tenants not in Tenants.
+	Default Quota
+	Tenants map[string]Quota
+}
+
+// WithQuotas rejects writes that would take a tenant over its quota.
+func WithQuotas(q Quotas) Option {
+	return func(c *Cache) {
+		c.quotas = &quotaState{Quotas: q, usage: map[string]*usage{}}
+	}
+}
+
+// TenantUsage is what a tenant stores, and its quota.
+type TenantUsage struct {
+	Tenant string
+	Keys   int64
+	Bytes  int64
+	Quota  Quota
+}
+
+type usage struct {
+	keys, bytes int64
+}
+
+// quotaState is the usage of every tenant that stores something.
+type quotaState struct {
+	Quotas
+	mu    sync.Mutex
+	usage map[string]*usage
+}
+
+// tenant returns the tenant of a key or list name.
+func (q *quotaState) tenant(name string) string {
+	if q.Separator == "" {
+		return ""
+	}
+	if i := strings.Index(name, q.Separator); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+func (q *quotaState) of(tenant string) Quota {
+	if quota, ok := q.Tenants[tenant]; ok {
+		return quota
+	}
+	return q.Default
+}
+
+// charge adds keys and bytes to the usage of the tenant of name, unless
+// that takes it over its quota.
+func (q *quotaState) charge(name string, keys, bytes int64) error {
+	tenant := q.tenant(name)
+	quota := q.of(tenant)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	u := q.get(tenant)
+	switch {
+	case keys > 0 && quota.MaxKeys > 0 && u.keys+keys > quota.MaxKeys:
+		return &Error{ResourceExhausted, fmt.Sprintf("tenant %q is at its quota of %d keys", tenant, quota.MaxKeys), "KEY_QUOTA_EXCEEDED"}
+	case bytes > 0 && quota.MaxBytes > 0 && u.bytes+bytes > quota.MaxBytes:
+		return &Error{ResourceExhausted, fmt.Sprintf("tenant %q would use %d of its quota of %d bytes", tenant, u.bytes+bytes, quota.MaxBytes), "BYTE_QUOTA_EXCEEDED"}
+	}
+	q.add(tenant, u, keys, bytes)
+	return nil
+}
+
+// record adds keys and bytes to the usage of the tenant of name, whatever
+// its quota.
+func (q *quotaState) record(name string, keys, bytes int64) {
+	tenant := q.tenant(name)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.add(tenant, q.get(tenant), keys, bytes)
+}
+
+// get returns the usage of tenant. q.mu must be held.
+func (q *quotaState) get(tenant string) *usage {
+	if u := q.usage[tenant]; u != nil {
+		return u
+	}
+	return &usage{}
+}
+
+// add changes the usage u of tenant, dropping it once it is empty. q.mu
+// must be held.
+func (q *quotaState) add(tenant string, u *usage, keys, bytes int64) {
+	u.keys += keys
+	u.bytes += bytes
+	if u.keys == 0 && u.bytes == 0 {
+		delete(q.usage, tenant)
+	} else {
+		q.usage[tenant] = u
+	}
+}
+
+// reset forgets all usage, for Load to count it again.
+func (q *quotaState) reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.usage = map[string]*usage{}
+}
+
+// checkList returns an error if a named list of n elements is at the quota
+// of its tenant.
+func (q *quotaState) checkList(list string, n int) error {
+	tenant := q.tenant(list)
+	if limit := q.of(tenant).MaxListLength; limit > 0 && n >= limit {
+		return &Error{ResourceExhausted, fmt.Sprintf("list %q is at the quota of %d elements of tenant %q", list, limit, tenant), "LIST_QUOTA_EXCEEDED"}
+	}
+	return nil
+}
+
+// Usage returns the usage of the tenants that store something or have a
+// quota of their own, sorted by tenant, and nil without WithQuotas.
+func (c *Cache) Usage() []TenantUsage {
+	q := c.quotas
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	res := []TenantUsage{}
+	for tenant, u := range q.usage {
+		res = append(res, TenantUsage{tenant, u.keys, u.bytes, q.of(tenant)})
+	}
+	for tenant, quota := range q.Tenants {
+		if q.usage[tenant] == nil {
+			res = append(res, TenantUsage{Tenant: tenant, Quota: quota})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Tenant < res[j].Tenant })
+	return res
+}
+
+// size returns the bytes key and its value count against a quota.
+func (s *valueStore[T]) size(key string, value T) int64 {
+	switch v := interface{}(value).(type) {
+	case string:
+		return int64(len(key) + len(v))
+	case []byte:
+		return int64(len(key) + len(v))
+	}
+	return int64(len(key) + len(s.t.codec.Append(nil, value)))
+}
+
+// elemSize returns the bytes an element of a named list counts against a
+// quota, and 0 without quotas.
+func (s *valueStore[T]) elemSize(value T) int64 {
+	if s.c.quotas == nil {
+		return 0
+	}
+	return s.size("", value)
+}
+
+// charges is the quota each key of one type takes in a shard. Named lists
+// are charged like keys, in charges of their own. Without quotas it does
+// nothing. The shard must be locked.
+type charges struct {
+	quotas *quotaState
+	sizes  map[string]int64
+}
+
+func newCharges(q *quotaState) charges {
+	if q == nil {
+		return charges{}
+	}
+	return charges{q, map[string]int64{}}
+}
+
+// charge takes the quota key needs to take size bytes instead of its
+// current ones, a new key taking one more key.
+func (c charges) charge(key string, size int64) error {
+	if c.quotas == nil {
+		return nil
+	}
+	old, exists := c.sizes[key]
+	keys := int64(1)
+	if exists {
+		keys = 0
+	}
+	if err := c.quotas.charge(key, keys, size-old); err != nil {
+		return err
+	}
+	c.sizes[key] = size
+	return nil
+}
+
+// grow charges bytes more for key, which takes the bytes of its name if it
+// is new.
+func (c charges) grow(key string, bytes int64) error {
+	if c.quotas == nil {
+		return nil
+	}
+	size, exists := c.sizes[key]
+	if !exists {
+		size = int64(len(key))
+	}
+	return c.charge(key, size+bytes)
+}
+
+// shrink gives back bytes of a key that stays stored.
+func (c charges) shrink(key string, bytes int64) {
+	if c.quotas == nil || bytes == 0 {
+		return
+	}
+	if _, exists := c.sizes[key]; exists {
+		c.sizes[key] -= bytes
+		c.quotas.record(key, 0, -bytes)
+	}
+}
+
+// forget gives back the quota of a key that is no longer stored.
+func (c charges) forget(key string) {
+	if c.quotas == nil {
+		return
+	}
+	if size, exists := c.sizes[key]; exists {
+		delete(c.sizes, key)
+		c.quotas.record(key, -1, -size)
+	}
+}
+
+// count records the size of a key loaded by Load, whatever the quota.
+func (c charges) count(key string, size int64) {
+	c.sizes[key] = size
+	c.quotas.record(key, 1, size)
+}
+
+// recount sets the usage of the keys and named lists in shards, after Load.
+func (s *valueStore[T]) recount(shards []*shard) {
+	for _, sh := range shards {
+		v := s.in(sh)
+		it := v.table.iter(false)
+		for {
+			key, value, _, ok := it.next()
+			if !ok {
+				break
+			}
+			v.charges.count(key, s.size(key, value))
+		}
+		for name, list := range v.lists {
+			size := int64(len(name))
+			for _, value := range list.Values() {
+				size += s.size("", value)
+			}
+			v.listCharges.count(name, size)
+		}
+	}
+}
+
+// recount sets the usage of the keys of the other types in sh, after Load.
+func (st stores) recount() {
+	for key, hash := range st.hashes.items {
+		st.hashes.charges.count(key, hashSize(key, hash.fields))
+	}
+	for key, members := range st.sets.items {
+		st.sets.charges.count(key, setSize(key, members))
+	}
+	for key, z := range st.sortedSets.items {
+		st.sortedSets.charges.count(key, sortedSetSize(key, z))
+	}
+	for key, item := range st.documents.items {
+		st.documents.charges.count(key, item.size(key))
+	}
+	for key, doc := range st.json.items {
+		st.json.charges.count(key, jsonSize(key, doc))
+	}
+}
+
+// hashSize returns the bytes a hash counts against a quota: its key and the
+// names and values of its fields.
+func hashSize(key string, hash map[string]string) int64 {
+	size := int64(len(key))
+	for field, value := range hash {
+		size += int64(len(field) + len(value))
+	}
+	return size
+}
+
+// setSize returns the bytes of the key and members of a set.
+func setSize(key string, members set) int64 {
+	size := int64(len(key))
+	for m := range members {
+		size += int64(len(m))
+	}
+	return size
+}
+
+// scoreSize is the bytes a score counts against a quota.
+const scoreSize = 8
+
+// sortedSetSize returns the bytes of the key, members and scores of a sorted
+// set.
+func sortedSetSize(key string, z *sortedSet) int64 {
+	size := int64(len(key))
+	for m := range z.scores {
+		size += int64(len(m) + scoreSize)
+	}
+	return size
+}
+
+// size returns the bytes of the key, type URL and value of a document.
+func (item documentItem) size(key string) int64 {
+	return int64(len(key) + len(item.TypeURL) + len(item.Value))
+}
+
+// jsonSize returns the bytes of the key and the encoded JSON of a document.
+func jsonSize(key string, doc interface{}) int64 {
+	// decoded documents always encode
+	b, _ := json.Marshal(doc)
+	return int64(len(key) + len(b))
+}
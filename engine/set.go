@@ -0,0 +1,320 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+type set map[string]struct{}
+
+// setCache stores sets of strings. A set exists while it has members.
+type setCache struct {
+	keyed[set]
+}
+
+// store replaces the set under key, removing it when members is empty. The
+// shard of key must be locked.
+func (c *Cache) store(key string, members set) error {
+	sh := c.shard(key)
+	if len(members) == 0 {
+		sh.sets.remove(key)
+		return nil
+	}
+	if err := sh.sets.charge(sh, key, setSize(key, members)); err != nil {
+		return err
+	}
+	sh.sets.items[key] = members
+	return nil
+}
+
+// SAdd adds members to a set, creating it if needed, and returns the number
+// of members that are new.
+func (c *Cache) SAdd(key string, add []string) (int, error) {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	members, exists := sh.sets.items[key]
+	if !exists && len(add) == 0 {
+		return 0, nil
+	}
+	if err := sh.sets.grow(sh, key, newMembersSize(members, add)); err != nil {
+		return 0, err
+	}
+	if !exists {
+		members = set{}
+		sh.sets.items[key] = members
+	}
+	added := 0
+	for _, m := range add {
+		if _, exists := members[m]; !exists {
+			members[m] = struct{}{}
+			added++
+		}
+	}
+	return added, nil
+}
+
+// newMembersSize returns the bytes of the members of add that aren't in
+// members, which may be nil, counting each once.
+func newMembersSize(members set, add []string) int64 {
+	var size int64
+	seen := set{}
+	for _, m := range add {
+		if _, exists := members[m]; exists {
+			continue
+		}
+		if _, exists := seen[m]; !exists {
+			seen[m] = struct{}{}
+			size += int64(len(m))
+		}
+	}
+	return size
+}
+
+// SRem removes members from a set and returns the number that existed.
+func (c *Cache) SRem(key string, remove []string) int {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	members := sh.sets.items[key]
+	removed := 0
+	var size int64
+	for _, m := range remove {
+		if _, exists := members[m]; exists {
+			delete(members, m)
+			removed++
+			size += int64(len(m))
+		}
+	}
+	sh.sets.charges.shrink(key, size)
+	if members != nil && len(members) == 0 {
+		sh.sets.remove(key)
+	}
+	return removed
+}
+
+func (c *Cache) SIsMember(key, member string) bool {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	_, exists := sh.sets.items[key][member]
+	return exists
+}
+
+// SMembers returns the members of a set in sorted order.
+func (c *Cache) SMembers(key string) []string {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.sets.items[key].sorted()
+}
+
+func (c *Cache) SCard(key string) int {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return len(sh.sets.items[key])
+}
+
+// maxRandomCount bounds the members SRandMember repeats unless
+// Limits.MaxRandomCount is set.
+const maxRandomCount = 1 << 16
+
+// SRandMember returns count random members without removing them. A
+// negative count returns -count members that may repeat, at most
+// Limits.MaxRandomCount.
+func (c *Cache) SRandMember(key string, count int64) ([]string, error) {
+	if count < -int64(c.limits.MaxRandomCount) {
+		return nil, &Error{InvalidArgument, fmt.Sprintf("count must not be below -%d, got %d", c.limits.MaxRandomCount, count), "COUNT_TOO_LARGE"}
+	}
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	members := sh.sets.items[key]
+	if len(members) == 0 {
+		return nil, nil
+	}
+	if count < 0 {
+		return members.choose(int(-count)), nil
+	}
+	n, _ := popCount(count)
+	return members.sample(n), nil
+}
+
+// SPop removes and returns random members.
+func (c *Cache) SPop(key string, count int64) ([]string, error) {
+	n, err := popCount(count)
+	if err != nil {
+		return nil, err
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	members := sh.sets.items[key]
+	values := members.sample(n)
+	var size int64
+	for _, m := range values {
+		delete(members, m)
+		size += int64(len(m))
+	}
+	sh.sets.charges.shrink(key, size)
+	if members != nil && len(members) == 0 {
+		sh.sets.remove(key)
+	}
+	return values, nil
+}
+
+func (c *Cache) SUnion(keys ...string) []string {
+	defer c.rlockKeys(keys...)()
+	return c.union(keys).sorted()
+}
+
+func (c *Cache) SInter(keys ...string) []string {
+	defer c.rlockKeys(keys...)()
+	return c.inter(keys).sorted()
+}
+
+// SDiff returns the members of the first set that are in none of the others.
+func (c *Cache) SDiff(keys ...string) []string {
+	defer c.rlockKeys(keys...)()
+	return c.diff(keys).sorted()
+}
+
+// SUnionStore stores the union of the sets under the destination key and
+// returns its size. An empty result deletes the destination.
+func (c *Cache) SUnionStore(destination string, keys ...string) (int, error) {
+	defer c.lockKeys(append([]string{destination}, keys...)...)()
+	return c.storeResult(destination, c.union(keys))
+}
+
+func (c *Cache) SInterStore(destination string, keys ...string) (int, error) {
+	defer c.lockKeys(append([]string{destination}, keys...)...)()
+	return c.storeResult(destination, c.inter(keys))
+}
+
+func (c *Cache) SDiffStore(destination string, keys ...string) (int, error) {
+	defer c.lockKeys(append([]string{destination}, keys...)...)()
+	return c.storeResult(destination, c.diff(keys))
+}
+
+func (c *Cache) DeleteSet(key string) bool {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.sets.remove(key)
+}
+
+func (c *Cache) storeResult(key string, members set) (int, error) {
+	if err := c.store(key, members); err != nil {
+		return 0, err
+	}
+	return len(members), nil
+}
+
+// members returns the set under key. Its shard must be locked.
+func (c *Cache) members(key string) set {
+	return c.shard(key).sets.items[key]
+}
+
+func (c *Cache) union(keys []string) set {
+	res := set{}
+	for _, k := range keys {
+		for m := range c.members(k) {
+			res[m] = struct{}{}
+		}
+	}
+	return res
+}
+
+func (c *Cache) inter(keys []string) set {
+	res := set{}
+	if len(keys) == 0 {
+		return res
+	}
+	for m := range c.members(keys[0]) {
+		in := true
+		for _, k := range keys[1:] {
+			if _, exists := c.members(k)[m]; !exists {
+				in = false
+				break
+			}
+		}
+		if in {
+			res[m] = struct{}{}
+		}
+	}
+	return res
+}
+
+func (c *Cache) diff(keys []string) set {
+	res := set{}
+	if len(keys) == 0 {
+		return res
+	}
+	for m := range c.members(keys[0]) {
+		res[m] = struct{}{}
+	}
+	for _, k := range keys[1:] {
+		for m := range c.members(k) {
+			delete(res, m)
+		}
+	}
+	return res
+}
+
+func (m set) sorted() []string {
+	members := make([]string, 0, len(m))
+	for member := range m {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// sample returns n distinct members chosen at random, or all of them if
+// there are fewer than n. It keeps a reservoir of n members while walking the
+// set once, so it doesn't copy or sort the whole set.
+func (m set) sample(n int) []string {
+	if n > len(m) {
+		n = len(m)
+	}
+	values := make([]string, 0, n)
+	seen := 0
+	for member := range m {
+		seen++
+		if len(values) < n {
+			values = append(values, member)
+		} else if j := rand.Intn(seen); j < n {
+			values[j] = member
+		}
+	}
+	rand.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
+	return values
+}
+
+// choose returns n members chosen at random that may repeat. It draws the
+// positions of the members first and then walks the set once to find them.
+func (m set) choose(n int) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	positions := make([]int, n)
+	for i := range positions {
+		positions[i] = rand.Intn(len(m))
+	}
+	sort.Ints(positions)
+	values := make([]string, 0, n)
+	i := 0
+	for member := range m {
+		for len(values) < n && positions[len(values)] == i {
+			values = append(values, member)
+		}
+		if len(values) == n {
+			break
+		}
+		i++
+	}
+	rand.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
+	return values
+}
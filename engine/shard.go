@@ -0,0 +1,203 @@
+package engine
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Keys are spread over shards by hash, and each shard has its own lock and
+// its own part of every store, so calls on different keys rarely wait for
+// each other. Named lists are placed by their name.
+//
+// Calls on one key lock its shard. Calls on several keys, and calls that
+// need the whole cache such as reads of the unnamed list and Save, lock
+// their shards in index order, so two calls never wait for each other.
+// Locks outside the shards, like the waiters of an unnamed list or the
+// registered document types, are always taken after shard locks.
+
+// DefaultShards is the number of shards unless WithShards sets another.
+const DefaultShards = 32
+
+type shard struct {
+	mu sync.RWMutex
+	// the part of each Store that belongs to the shard, by type
+	values []interface{}
+	stores
+}
+
+// stores holds the part of the other stores that belongs to a shard.
+type stores struct {
+	hashes     *hashCache
+	sets       *setCache
+	sortedSets *sortedSetCache
+	documents  *documentCache
+	json       *jsonCache
+}
+
+// keyCounts holds the number of keys of the types without a Store over all
+// shards, for the eviction limit. The counts are changed atomically.
+type keyCounts struct {
+	hashes, sets, sortedSets, documents, json int64
+}
+
+func WithShards(n int) Option {
+	return func(c *Cache) {
+		if n > 0 {
+			c.shards = make([]*shard, n)
+		}
+	}
+}
+
+// newShard returns an empty shard of c.
+func (c *Cache) newShard() *shard {
+	sh := &shard{stores: stores{
+		hashes:     &hashCache{newKeyed(c, &c.keys.hashes, func(sh *shard) *keyed[*hash] { return &sh.hashes.keyed })},
+		sets:       &setCache{newKeyed(c, &c.keys.sets, func(sh *shard) *keyed[set] { return &sh.sets.keyed })},
+		sortedSets: &sortedSetCache{newKeyed(c, &c.keys.sortedSets, func(sh *shard) *keyed[*sortedSet] { return &sh.sortedSets.keyed })},
+		documents:  &documentCache{newKeyed(c, &c.keys.documents, func(sh *shard) *keyed[documentItem] { return &sh.documents.keyed })},
+		json:       &jsonCache{newKeyed(c, &c.keys.json, func(sh *shard) *keyed[interface{}] { return &sh.json.keyed })},
+	}}
+	for _, s := range c.stores {
+		sh.values = append(sh.values, s.newValues())
+	}
+	return sh
+}
+
+// index returns the shard of a key or list name, using 32-bit FNV-1a.
+func (c *Cache) index(key string) int {
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= 16777619
+	}
+	return int(h % uint32(len(c.shards)))
+}
+
+func (c *Cache) shard(key string) *shard {
+	return c.shards[c.index(key)]
+}
+
+// lockKeys locks the shards of keys in index order and returns the function
+// that unlocks them.
+func (c *Cache) lockKeys(keys ...string) func() {
+	indices := c.indices(keys)
+	for _, i := range indices {
+		c.shards[i].mu.Lock()
+	}
+	return func() {
+		for j := len(indices) - 1; j >= 0; j-- {
+			c.shards[indices[j]].mu.Unlock()
+		}
+	}
+}
+
+// rlockKeys is lockKeys for reading.
+func (c *Cache) rlockKeys(keys ...string) func() {
+	indices := c.indices(keys)
+	for _, i := range indices {
+		c.shards[i].mu.RLock()
+	}
+	return func() {
+		for j := len(indices) - 1; j >= 0; j-- {
+			c.shards[indices[j]].mu.RUnlock()
+		}
+	}
+}
+
+// indices returns the shards of keys in ascending order, each once.
+func (c *Cache) indices(keys []string) []int {
+	var indices []int
+	seen := map[int]bool{}
+	for _, key := range keys {
+		if i := c.index(key); !seen[i] {
+			seen[i] = true
+			indices = append(indices, i)
+		}
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// lockAll locks every shard and returns the function that unlocks them.
+func (c *Cache) lockAll() func() {
+	for _, sh := range c.shards {
+		sh.mu.Lock()
+	}
+	return func() {
+		for i := len(c.shards) - 1; i >= 0; i-- {
+			c.shards[i].mu.Unlock()
+		}
+	}
+}
+
+// rlockAll read-locks every shard and returns the function that unlocks them.
+func (c *Cache) rlockAll() func() {
+	for _, sh := range c.shards {
+		sh.mu.RLock()
+	}
+	return func() {
+		for i := len(c.shards) - 1; i >= 0; i-- {
+			c.shards[i].mu.RUnlock()
+		}
+	}
+}
+
+// lockList locks the shard of a named list, or every shard for the unnamed
+// list, and returns the function that unlocks it.
+func (c *Cache) lockList(name string) func() {
+	if name == "" {
+		return c.lockAll()
+	}
+	sh := c.shard(name)
+	sh.mu.Lock()
+	return sh.mu.Unlock
+}
+
+// rlockList is lockList for reading.
+func (c *Cache) rlockList(name string) func() {
+	if name == "" {
+		return c.rlockAll()
+	}
+	sh := c.shard(name)
+	sh.mu.RLock()
+	return sh.mu.RUnlock
+}
+
+// reserve makes room for a new key of a type that has count keys over all
+// shards. When the limit is reached and the policy allows it, a key is
+// evicted with evict, from sh if it has one and from a shard no one holds
+// otherwise. sh must be locked.
+func (c *Cache) reserve(sh *shard, count *int64, evict func(sh *shard) bool) error {
+	for {
+		n := atomic.LoadInt64(count)
+		if c.eviction.MaxKeys == 0 || n < int64(c.eviction.MaxKeys) {
+			if atomic.CompareAndSwapInt64(count, n, n+1) {
+				return nil
+			}
+			continue
+		}
+		if c.eviction.Policy != EvictionRandom || !c.evict(sh, evict) {
+			return ErrCacheFull
+		}
+	}
+}
+
+func (c *Cache) evict(sh *shard, evict func(sh *shard) bool) bool {
+	if evict(sh) {
+		return true
+	}
+	// shards locked by the caller or by others are skipped, waiting for
+	// them could deadlock
+	for _, other := range c.shards {
+		if other == sh || !other.mu.TryLock() {
+			continue
+		}
+		evicted := evict(other)
+		other.mu.Unlock()
+		if evicted {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package engine
+
+import "math/rand"
+
+// maxLevel bounds the height of a skiplist node, enough for 4^32 members.
+const maxLevel = 32
+
+// skiplist keeps members ordered by score, then by member. Every link
+// records how many nodes it skips, so ranks are found in O(log n) as well.
+type skiplist struct {
+	head   *skipNode
+	tail   *skipNode
+	length int
+	level  int
+}
+
+type skipNode struct {
+	member   string
+	score    float64
+	backward *skipNode
+	level    []skipLink
+}
+
+type skipLink struct {
+	forward *skipNode
+	span    int
+}
+
+func newSkiplist() *skiplist {
+	return &skiplist{
+		head:  &skipNode{level: make([]skipLink, maxLevel)},
+		level: 1,
+	}
+}
+
+// before reports whether n sorts before score and member.
+func (n *skipNode) before(score float64, member string) bool {
+	return n.score < score || (n.score == score && n.member < member)
+}
+
+// after reports whether n sorts after score and member.
+func (n *skipNode) after(score float64, member string) bool {
+	return n.score > score || (n.score == score && n.member > member)
+}
+
+func randomLevel() int {
+	level := 1
+	for level < maxLevel && rand.Intn(4) == 0 {
+		level++
+	}
+	return level
+}
+
+// Insert adds a member that is not in the list yet.
+func (l *skiplist) Insert(score float64, member string) {
+	var update [maxLevel]*skipNode
+	var rank [maxLevel]int
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.level[i].forward != nil && x.level[i].forward.before(score, member) {
+			rank[i] += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+	level := randomLevel()
+	if level > l.level {
+		for i := l.level; i < level; i++ {
+			update[i] = l.head
+			update[i].level[i].span = l.length
+		}
+		l.level = level
+	}
+	x = &skipNode{member: member, score: score, level: make([]skipLink, level)}
+	for i := 0; i < level; i++ {
+		x.level[i].forward = update[i].level[i].forward
+		update[i].level[i].forward = x
+		x.level[i].span = update[i].level[i].span - (rank[0] - rank[i])
+		update[i].level[i].span = rank[0] - rank[i] + 1
+	}
+	for i := level; i < l.level; i++ {
+		update[i].level[i].span++
+	}
+	if update[0] != l.head {
+		x.backward = update[0]
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x
+	} else {
+		l.tail = x
+	}
+	l.length++
+}
+
+// Delete removes a member with the given score, reporting whether it was found.
+func (l *skiplist) Delete(score float64, member string) bool {
+	var update [maxLevel]*skipNode
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && x.level[i].forward.before(score, member) {
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+	x = x.level[0].forward
+	if x == nil || x.score != score || x.member != member {
+		return false
+	}
+	for i := 0; i < l.level; i++ {
+		if update[i].level[i].forward == x {
+			update[i].level[i].span += x.level[i].span - 1
+			update[i].level[i].forward = x.level[i].forward
+		} else {
+			update[i].level[i].span--
+		}
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x.backward
+	} else {
+		l.tail = x.backward
+	}
+	for l.level > 1 && l.head.level[l.level-1].forward == nil {
+		l.level--
+	}
+	l.length--
+	return true
+}
+
+// Rank returns the 1-based position of a member, or 0 if it isn't there.
+func (l *skiplist) Rank(score float64, member string) int {
+	rank := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !x.level[i].forward.after(score, member) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+		if x != l.head && x.score == score && x.member == member {
+			return rank
+		}
+	}
+	return 0
+}
+
+// ByRank returns the node at a 1-based position, or nil.
+func (l *skiplist) ByRank(rank int) *skipNode {
+	traversed := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && traversed+x.level[i].span <= rank {
+			traversed += x.level[i].span
+			x = x.level[i].forward
+		}
+		if traversed == rank && x != l.head {
+			return x
+		}
+	}
+	return nil
+}
+
+// First returns the first node for which atLeast is true. atLeast must be
+// false for a prefix of the list and true for the rest.
+func (l *skiplist) First(atLeast func(n *skipNode) bool) *skipNode {
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !atLeast(x.level[i].forward) {
+			x = x.level[i].forward
+		}
+	}
+	return x.level[0].forward
+}
+
+// Last returns the last node for which atMost is true. atMost must be true
+// for a prefix of the list and false for the rest.
+func (l *skiplist) Last(atMost func(n *skipNode) bool) *skipNode {
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && atMost(x.level[i].forward) {
+			x = x.level[i].forward
+		}
+	}
+	if x == l.head {
+		return nil
+	}
+	return x
+}
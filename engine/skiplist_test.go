@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+type scored struct {
+	member string
+	score  float64
+}
+
+func TestSkiplistMatchesSortedSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	l := newSkiplist()
+	scores := map[string]float64{}
+	for i := 0; i < 20000; i++ {
+		member := fmt.Sprint(r.Intn(500))
+		if score, exists := scores[member]; exists {
+			if !l.Delete(score, member) {
+				t.Fatalf("Delete(%v, %s) found nothing", score, member)
+			}
+			delete(scores, member)
+		}
+		if r.Intn(3) > 0 {
+			score := float64(r.Intn(50))
+			l.Insert(score, member)
+			scores[member] = score
+		}
+	}
+
+	var want []scored
+	for member, score := range scores {
+		want = append(want, scored{member, score})
+	}
+	sort.Slice(want, func(i, j int) bool {
+		return want[i].score < want[j].score || (want[i].score == want[j].score && want[i].member < want[j].member)
+	})
+	if l.length != len(want) {
+		t.Fatalf("length is %d, want %d", l.length, len(want))
+	}
+	var prev *skipNode
+	for i, w := range want {
+		n := l.ByRank(i + 1)
+		if n == nil || n.member != w.member || n.score != w.score {
+			t.Fatalf("ByRank(%d) is %v, want %v", i+1, n, w)
+		}
+		if n.backward != prev {
+			t.Fatalf("wrong backward link at rank %d", i+1)
+		}
+		if rank := l.Rank(w.score, w.member); rank != i+1 {
+			t.Fatalf("Rank(%v) is %d, want %d", w, rank, i+1)
+		}
+		prev = n
+	}
+	if l.tail != prev {
+		t.Fatal("wrong tail")
+	}
+	if l.Rank(1000, "missing") != 0 || l.ByRank(len(want)+1) != nil {
+		t.Error("expected no match past the end")
+	}
+
+	first := l.First(func(n *skipNode) bool { return n.score >= 10 })
+	last := l.Last(func(n *skipNode) bool { return n.score < 20 })
+	i := sort.Search(len(want), func(i int) bool { return want[i].score >= 10 })
+	j := sort.Search(len(want), func(i int) bool { return want[i].score >= 20 }) - 1
+	if first.member != want[i].member || last.member != want[j].member {
+		t.Errorf("range is %s..%s, want %s..%s", first.member, last.member, want[i].member, want[j].member)
+	}
+}
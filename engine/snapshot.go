@@ -0,0 +1,243 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// snapshot is the on-disk form of the cache.
+type snapshot struct {
+	// snapshots of the stores by type name, encoded separately as their
+	// types differ
+	Values map[string][]byte
+	// the string, int, float and bytes values of snapshots written before
+	// Values
+	Strings     map[string]legacyItem[string]
+	StringKeys  []string
+	StringLists map[string][]string
+	Ints        map[string]legacyItem[int64]
+	IntKeys     []string
+	IntLists    map[string][]int64
+	Floats      map[string]legacyItem[float64]
+	FloatKeys   []string
+	FloatLists  map[string][]float64
+	Bytes       map[string]legacyItem[[]byte]
+	BytesKeys   []string
+	BytesLists  map[string][][]byte
+
+	Hashes     map[string]map[string]string
+	Sets       map[string][]string
+	SortedSets map[string]map[string]float64
+	Documents  map[string]documentItem
+	Types      [][]byte
+	JSON       map[string]string
+}
+
+// Save writes a snapshot of the whole cache to w.
+func (c *Cache) Save(w io.Writer) error {
+	defer c.rlockAll()()
+	c.typesMu.RLock()
+	files := c.files
+	c.typesMu.RUnlock()
+	types := make([][]byte, len(files))
+	for i, f := range files {
+		b, err := proto.Marshal(f)
+		if err != nil {
+			return err
+		}
+		types[i] = b
+	}
+	docs := map[string]string{}
+	for _, sh := range c.shards {
+		for key, v := range sh.json.items {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			docs[key] = string(b)
+		}
+	}
+	vals := map[string][]byte{}
+	for i, s := range c.stores {
+		b, err := s.save(c.shards)
+		if err != nil {
+			return err
+		}
+		vals[registered[i].name()] = b
+	}
+	return gob.NewEncoder(w).Encode(snapshot{
+		Values:     vals,
+		Hashes:     fields(combine(c.shards, func(sh *shard) map[string]*hash { return sh.hashes.items })),
+		Sets:       members(combine(c.shards, func(sh *shard) map[string]set { return sh.sets.items })),
+		SortedSets: scores(combine(c.shards, func(sh *shard) map[string]*sortedSet { return sh.sortedSets.items })),
+		Documents:  combine(c.shards, func(sh *shard) map[string]documentItem { return sh.documents.items }),
+		Types:      types,
+		JSON:       docs,
+	})
+}
+
+// Load replaces the contents of the cache with a snapshot read from r.
+func (c *Cache) Load(r io.Reader) error {
+	var s snapshot
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return err
+	}
+	shards := make([]*shard, len(c.shards))
+	for i := range shards {
+		shards[i] = c.newShard()
+	}
+	// after the swap below, shards holds the old values
+	defer c.release(shards)
+	if s.Values == nil {
+		if err := s.upgrade(); err != nil {
+			return err
+		}
+	}
+	for i, st := range c.stores {
+		// types registered after the snapshot was saved start out empty
+		if b, ok := s.Values[registered[i].name()]; ok {
+			if err := st.load(shards, b); err != nil {
+				return err
+			}
+		}
+	}
+	for key, hash := range s.Hashes {
+		shards[c.index(key)].hashes.items[key] = newHash(hash)
+	}
+	for key, members := range s.Sets {
+		m := set{}
+		for _, member := range members {
+			m[member] = struct{}{}
+		}
+		shards[c.index(key)].sets.items[key] = m
+	}
+	for key, scores := range s.SortedSets {
+		z := newSortedSet()
+		for member, score := range scores {
+			z.add(member, score)
+		}
+		shards[c.index(key)].sortedSets.items[key] = z
+	}
+	for key, doc := range s.Documents {
+		shards[c.index(key)].documents.items[key] = doc
+	}
+	for key, text := range s.JSON {
+		doc, err := decodeJSON(text)
+		if err != nil {
+			return err
+		}
+		shards[c.index(key)].json.items[key] = doc
+	}
+	files := make([]*descriptorpb.FileDescriptorProto, len(s.Types))
+	for i, b := range s.Types {
+		files[i] = &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(b, files[i]); err != nil {
+			return err
+		}
+	}
+	types, err := buildTypes(files)
+	if err != nil {
+		return err
+	}
+
+	defer c.lockAll()()
+	c.keys = keyCounts{}
+	for i, sh := range c.shards {
+		// calls blocked on named lists keep waiting
+		for _, s := range c.stores {
+			s.adopt(shards[i], sh)
+		}
+		sh.values, shards[i].values = shards[i].values, sh.values
+		sh.stores = shards[i].stores
+		c.keys.hashes += int64(len(sh.hashes.items))
+		c.keys.sets += int64(len(sh.sets.items))
+		c.keys.sortedSets += int64(len(sh.sortedSets.items))
+		c.keys.documents += int64(len(sh.documents.items))
+		c.keys.json += int64(len(sh.json.items))
+	}
+	if c.quotas != nil {
+		c.quotas.reset()
+		for _, sh := range c.shards {
+			sh.stores.recount()
+		}
+	}
+	for _, s := range c.stores {
+		s.count(c.shards)
+	}
+	c.typesMu.Lock()
+	c.files, c.types = files, types
+	c.typesMu.Unlock()
+	return nil
+}
+
+// legacyItem is a stored value in snapshots written before Values.
+type legacyItem[T any] struct {
+	Value T
+}
+
+// upgrade moves the values of a snapshot written before Values to Values.
+func (s *snapshot) upgrade() error {
+	s.Values = map[string][]byte{}
+	for name, snap := range map[string]interface{}{
+		String.Name: legacyValues(s.Strings, s.StringKeys, s.StringLists),
+		Int.Name:    legacyValues(s.Ints, s.IntKeys, s.IntLists),
+		Float.Name:  legacyValues(s.Floats, s.FloatKeys, s.FloatLists),
+		Bytes.Name:  legacyValues(s.Bytes, s.BytesKeys, s.BytesLists),
+	} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(snap); err != nil {
+			return err
+		}
+		s.Values[name] = buf.Bytes()
+	}
+	return nil
+}
+
+func legacyValues[T any](items map[string]legacyItem[T], keys []string, lists map[string][]T) valueSnapshot[T] {
+	snap := valueSnapshot[T]{Items: make(map[string]T, len(items)), Keys: keys, Lists: lists}
+	for key, item := range items {
+		snap.Items[key] = item.Value
+	}
+	return snap
+}
+
+// combine puts the maps items returns for each shard into one.
+func combine[V any](shards []*shard, items func(sh *shard) map[string]V) map[string]V {
+	res := map[string]V{}
+	for _, sh := range shards {
+		for key, v := range items(sh) {
+			res[key] = v
+		}
+	}
+	return res
+}
+
+// members lists the members of every set, as gob can't encode empty structs.
+func members(items map[string]set) map[string][]string {
+	res := make(map[string][]string, len(items))
+	for key, members := range items {
+		res[key] = members.sorted()
+	}
+	return res
+}
+
+func fields(items map[string]*hash) map[string]map[string]string {
+	res := make(map[string]map[string]string, len(items))
+	for key, h := range items {
+		res[key] = h.fields
+	}
+	return res
+}
+
+func scores(items map[string]*sortedSet) map[string]map[string]float64 {
+	res := make(map[string]map[string]float64, len(items))
+	for key, z := range items {
+		res[key] = z.scores
+	}
+	return res
+}
@@ -0,0 +1,414 @@
+package engine
+
+import (
+	"math"
+)
+
+var (
+	errNaNScore      = newError(InvalidArgument, "score must be a number")
+	errInfiniteScore = &Error{InvalidArgument, "score must be finite", "VALUE_NOT_FINITE"}
+)
+
+// checkScore rejects a score sorted sets can't store.
+func (c *Cache) checkScore(score float64) error {
+	switch {
+	case math.IsNaN(score):
+		return errNaNScore
+	case math.IsInf(score, 0) && c.limits.FiniteScores:
+		return errInfiniteScore
+	}
+	return nil
+}
+
+// sortedSet maps members to scores and keeps them ordered in a skiplist.
+type sortedSet struct {
+	scores map[string]float64
+	list   *skiplist
+}
+
+func newSortedSet() *sortedSet {
+	return &sortedSet{map[string]float64{}, newSkiplist()}
+}
+
+// add sets the score of a member and reports whether the member is new.
+func (z *sortedSet) add(member string, score float64) bool {
+	old, exists := z.scores[member]
+	if exists {
+		if old == score {
+			return false
+		}
+		z.list.Delete(old, member)
+	}
+	z.scores[member] = score
+	z.list.Insert(score, member)
+	return !exists
+}
+
+func (z *sortedSet) remove(member string) bool {
+	score, exists := z.scores[member]
+	if exists {
+		delete(z.scores, member)
+		z.list.Delete(score, member)
+	}
+	return exists
+}
+
+// sortedSetCache stores sorted sets. A sorted set exists while it has members.
+type sortedSetCache struct {
+	keyed[*sortedSet]
+}
+
+// createSortedSet returns the sorted set under key in sh, creating it if
+// needed, after charging size bytes more for it.
+func (c *Cache) createSortedSet(sh *shard, key string, size int64) (*sortedSet, error) {
+	if err := sh.sortedSets.grow(sh, key, size); err != nil {
+		return nil, err
+	}
+	if z, exists := sh.sortedSets.items[key]; exists {
+		return z, nil
+	}
+	z := newSortedSet()
+	sh.sortedSets.items[key] = z
+	return z, nil
+}
+
+// tidy drops a sorted set that has no members left.
+func (s *sortedSetCache) tidy(key string) {
+	if z, exists := s.items[key]; exists && len(z.scores) == 0 {
+		s.remove(key)
+	}
+}
+
+// ZMember is a member of a sorted set with its score.
+type ZMember struct {
+	Member string
+	Score  float64
+}
+
+// ScoreRange selects members by score. Min and Max are included unless
+// marked exclusive and may be infinite. Offset members are skipped and at
+// most Count returned, all of them if Count is 0.
+type ScoreRange struct {
+	Min, Max                   float64
+	MinExclusive, MaxExclusive bool
+	Reverse                    bool
+	Offset, Count              int64
+}
+
+// LexRange selects members of a sorted set with equal scores by member. An
+// empty Max has no upper bound.
+type LexRange struct {
+	Min, Max                   string
+	MinExclusive, MaxExclusive bool
+	Reverse                    bool
+	Offset, Count              int64
+}
+
+// ZAdd sets the scores of members, creating the sorted set if needed, and
+// returns the number of members that are new.
+func (c *Cache) ZAdd(key string, members []ZMember) (int, error) {
+	for _, m := range members {
+		if err := c.checkScore(m.Score); err != nil {
+			return 0, err
+		}
+	}
+	if len(members) == 0 {
+		return 0, nil
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	z := sh.sortedSets.items[key]
+	var size int64
+	seen := map[string]bool{}
+	for _, m := range members {
+		if !z.has(m.Member) && !seen[m.Member] {
+			seen[m.Member] = true
+			size += int64(len(m.Member) + scoreSize)
+		}
+	}
+	z, err := c.createSortedSet(sh, key, size)
+	if err != nil {
+		return 0, err
+	}
+	added := 0
+	for _, m := range members {
+		if z.add(m.Member, m.Score) {
+			added++
+		}
+	}
+	return added, nil
+}
+
+// ZIncrBy adds delta to the score of a member, treating a missing member as
+// 0, and returns the new score.
+func (c *Cache) ZIncrBy(key, member string, delta float64) (float64, error) {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	z := sh.sortedSets.items[key]
+	score := z.score(member) + delta
+	if err := c.checkScore(score); err != nil {
+		return 0, err
+	}
+	var size int64
+	if !z.has(member) {
+		size = int64(len(member) + scoreSize)
+	}
+	z, err := c.createSortedSet(sh, key, size)
+	if err != nil {
+		return 0, err
+	}
+	z.add(member, score)
+	return score, nil
+}
+
+func (c *Cache) ZScore(key, member string) (float64, error) {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	z, exists := sh.sortedSets.items[key]
+	if !exists {
+		return 0, ErrNoMember
+	}
+	score, exists := z.scores[member]
+	if !exists {
+		return 0, ErrNoMember
+	}
+	return score, nil
+}
+
+// ZRank returns the 0-based rank of a member, counting from the lowest score
+// or from the highest one if reverse is set.
+func (c *Cache) ZRank(key, member string, reverse bool) (int, error) {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	z, exists := sh.sortedSets.items[key]
+	if !exists {
+		return 0, ErrNoMember
+	}
+	score, exists := z.scores[member]
+	if !exists {
+		return 0, ErrNoMember
+	}
+	rank := z.list.Rank(score, member) - 1
+	if reverse {
+		rank = z.list.length - 1 - rank
+	}
+	return rank, nil
+}
+
+// ZRange returns the members ranked start to stop, both included.
+func (c *Cache) ZRange(key string, start, stop int64, reverse bool) []ZMember {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	z, exists := sh.sortedSets.items[key]
+	if !exists {
+		return nil
+	}
+	n := z.list.length
+	from, to := span(start, stop, n)
+	if from == to {
+		return nil
+	}
+	if reverse {
+		return collect(z.list.ByRank(n-from), true, to-from)
+	}
+	return collect(z.list.ByRank(from+1), false, to-from)
+}
+
+func (c *Cache) ZRangeByScore(key string, r ScoreRange) ([]ZMember, error) {
+	atLeast, atMost, err := scoreBounds(r)
+	if err != nil {
+		return nil, err
+	}
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.sortedSets.items[key].between(atLeast, atMost, r.Reverse, r.Offset, r.Count)
+}
+
+func (c *Cache) ZRangeByLex(key string, r LexRange) ([]ZMember, error) {
+	atLeast := func(n *skipNode) bool {
+		return n.member > r.Min || (!r.MinExclusive && n.member == r.Min)
+	}
+	atMost := func(n *skipNode) bool {
+		return r.Max == "" || n.member < r.Max || (!r.MaxExclusive && n.member == r.Max)
+	}
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.sortedSets.items[key].between(atLeast, atMost, r.Reverse, r.Offset, r.Count)
+}
+
+// ZCount returns the number of members with scores in the range. Offset,
+// count and reverse are ignored.
+func (c *Cache) ZCount(key string, r ScoreRange) (int, error) {
+	atLeast, atMost, err := scoreBounds(r)
+	if err != nil {
+		return 0, err
+	}
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	z, exists := sh.sortedSets.items[key]
+	if !exists {
+		return 0, nil
+	}
+	first, last := z.list.First(atLeast), z.list.Last(atMost)
+	if first == nil || last == nil || !atMost(first) {
+		return 0, nil
+	}
+	return z.list.Rank(last.score, last.member) - z.list.Rank(first.score, first.member) + 1, nil
+}
+
+func (c *Cache) ZCard(key string) int {
+	sh := c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	if z, exists := sh.sortedSets.items[key]; exists {
+		return len(z.scores)
+	}
+	return 0
+}
+
+// ZRem removes members and returns the number that existed.
+func (c *Cache) ZRem(key string, members []string) int {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	z, exists := sh.sortedSets.items[key]
+	if !exists {
+		return 0
+	}
+	removed := 0
+	var size int64
+	for _, member := range members {
+		if z.remove(member) {
+			removed++
+			size += int64(len(member) + scoreSize)
+		}
+	}
+	sh.sortedSets.charges.shrink(key, size)
+	sh.sortedSets.tidy(key)
+	return removed
+}
+
+// ZPopMin removes and returns the members with the lowest scores.
+func (c *Cache) ZPopMin(key string, count int64) ([]ZMember, error) {
+	return c.zpop(key, count, false)
+}
+
+// ZPopMax removes and returns the members with the highest scores.
+func (c *Cache) ZPopMax(key string, count int64) ([]ZMember, error) {
+	return c.zpop(key, count, true)
+}
+
+func (c *Cache) DeleteSortedSet(key string) bool {
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.sortedSets.remove(key)
+}
+
+func (c *Cache) zpop(key string, count int64, max bool) ([]ZMember, error) {
+	n, err := popCount(count)
+	if err != nil {
+		return nil, err
+	}
+	sh := c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	z, exists := sh.sortedSets.items[key]
+	if !exists {
+		return nil, nil
+	}
+	var res []ZMember
+	var size int64
+	for len(res) < n && z.list.length > 0 {
+		node := z.list.head.level[0].forward
+		if max {
+			node = z.list.tail
+		}
+		res = append(res, ZMember{node.member, node.score})
+		z.remove(node.member)
+		size += int64(len(node.member) + scoreSize)
+	}
+	sh.sortedSets.charges.shrink(key, size)
+	sh.sortedSets.tidy(key)
+	return res, nil
+}
+
+// score returns the score of a member, 0 if it or the set doesn't exist.
+func (z *sortedSet) score(member string) float64 {
+	if z == nil {
+		return 0
+	}
+	return z.scores[member]
+}
+
+// has reports whether member is in the set, false if the set doesn't exist.
+func (z *sortedSet) has(member string) bool {
+	if z == nil {
+		return false
+	}
+	_, exists := z.scores[member]
+	return exists
+}
+
+// between returns the members for which atLeast and atMost are both true,
+// skipping offset of them and returning at most count, or all if count is 0.
+func (z *sortedSet) between(atLeast, atMost func(n *skipNode) bool, reverse bool, offset, count int64) ([]ZMember, error) {
+	if offset < 0 || count < 0 {
+		return nil, newError(InvalidArgument, "offset and count must not be negative")
+	}
+	if z == nil {
+		return nil, nil
+	}
+	var res []ZMember
+	var node *skipNode
+	if reverse {
+		node = z.list.Last(atMost)
+	} else {
+		node = z.list.First(atLeast)
+	}
+	for ; node != nil && offset > 0; offset-- {
+		node = step(node, reverse)
+	}
+	for node != nil && atLeast(node) && atMost(node) && (count == 0 || int64(len(res)) < count) {
+		res = append(res, ZMember{node.member, node.score})
+		node = step(node, reverse)
+	}
+	return res, nil
+}
+
+func scoreBounds(r ScoreRange) (atLeast, atMost func(n *skipNode) bool, err error) {
+	if math.IsNaN(r.Min) || math.IsNaN(r.Max) {
+		return nil, nil, errNaNScore
+	}
+	atLeast = func(n *skipNode) bool {
+		return n.score > r.Min || (!r.MinExclusive && n.score == r.Min)
+	}
+	atMost = func(n *skipNode) bool {
+		return n.score < r.Max || (!r.MaxExclusive && n.score == r.Max)
+	}
+	return atLeast, atMost, nil
+}
+
+// collect returns n members starting at node.
+func collect(node *skipNode, reverse bool, n int) []ZMember {
+	items := make([]ZMember, 0, n)
+	for ; node != nil && len(items) < n; node = step(node, reverse) {
+		items = append(items, ZMember{node.member, node.score})
+	}
+	return items
+}
+
+func step(node *skipNode, reverse bool) *skipNode {
+	if reverse {
+		return node.backward
+	}
+	return node.level[0].forward
+}
@@ -0,0 +1,696 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Type is a value type that can be stored under keys and in lists. Every
+// Engine has a Store for each registered type.
+type Type[T any] struct {
+	Name  string
+	id    int
+	equal func(a, b T) bool
+	codec Codec[T]
+}
+
+// The value types of the service. Other types are added with Register.
+var (
+	String = RegisterCodec("string", func(a, b string) bool { return a == b }, stringCodec)
+	Int    = RegisterCodec("int", func(a, b int64) bool { return a == b }, intCodec)
+	Float  = RegisterCodec("float", func(a, b float64) bool { return a == b }, floatCodec)
+	Bytes  = RegisterCodec("bytes", bytes.Equal, bytesCodec)
+)
+
+// valueType is the part of a Type that doesn't depend on T.
+type valueType interface {
+	name() string
+	newStore(c *Cache) store
+}
+
+// registered holds the value types in registration order.
+var registered []valueType
+
+// Register adds a value type, whose values are compared with equal by
+// ListInsert and ListRemove. Types must be registered before the first
+// Cache is created, usually from an init function, and values of T must be
+// encodable with encoding/gob to be saved in snapshots.
+func Register[T any](name string, equal func(a, b T) bool) *Type[T] {
+	return RegisterCodec(name, equal, gobCodec[T]())
+}
+
+// RegisterCodec is Register with a faster codec than encoding/gob for
+// arenas.
+func RegisterCodec[T any](name string, equal func(a, b T) bool, codec Codec[T]) *Type[T] {
+	for _, t := range registered {
+		if t.name() == name {
+			panic("engine: type " + name + " registered twice")
+		}
+	}
+	t := &Type[T]{name, len(registered), equal, codec}
+	registered = append(registered, t)
+	return t
+}
+
+func (t *Type[T]) name() string {
+	return t.Name
+}
+
+func (t *Type[T]) newStore(c *Cache) store {
+	return &valueStore[T]{c: c, t: t}
+}
+
+// store is the part of a valueStore that doesn't depend on T, for the calls
+// that work on every type.
+type store interface {
+	newValues() interface{}
+	lists(sh *shard) []ListInfo
+	save(shards []*shard) ([]byte, error)
+	load(shards []*shard, b []byte) error
+	// adopt moves the calls waiting on named lists in old to sh, which
+	// replaces it
+	adopt(sh, old *shard)
+	count(shards []*shard)
+	// release closes the table of sh if it holds files
+	release(sh *shard) error
+}
+
+// valueStore is the Store of a Cache.
+type valueStore[T any] struct {
+	// number of keys over all shards, for the eviction limit
+	keys    int64
+	c       *Cache
+	t       *Type[T]
+	unnamed unnamedList
+	// index of the unnamed list for reads by index
+	index keyIndex[T]
+}
+
+// storeOf returns the store of c for values of type t.
+func storeOf[T any](c *Cache, t *Type[T]) *valueStore[T] {
+	return c.stores[t.id].(*valueStore[T])
+}
+
+// values is the part of a valueStore that belongs to a shard.
+type values[T any] struct {
+	table table[T]
+	lists map[string]*deque[T]
+	// calls blocked on an empty named list, by list name
+	waiters waitQueues
+	keys    *int64
+	unnamed *unnamedList
+	// the quota each key and named list takes
+	charges, listCharges charges
+}
+
+func (s *valueStore[T]) newValues() interface{} {
+	return &values[T]{
+		s.newTable(),
+		map[string]*deque[T]{},
+		waitQueues{},
+		&s.keys,
+		&s.unnamed,
+		newCharges(s.c.quotas),
+		newCharges(s.c.quotas),
+	}
+}
+
+// newTable returns an empty table for the keys of a shard.
+func (s *valueStore[T]) newTable() table[T] {
+	if s.c.tiers != nil {
+		return newTieredTable(&s.t.codec, s.c.tiers, s.t.Name)
+	}
+	if s.c.arena {
+		return newArenaTable(&s.t.codec)
+	}
+	return newMapTable[T]()
+}
+
+// in returns the values of sh.
+func (s *valueStore[T]) in(sh *shard) *values[T] {
+	return sh.values[s.t.id].(*values[T])
+}
+
+// put stores key at the back of the unnamed list, or at the front if front
+// is set. A key that is already stored moves to its new place, a new one
+// must have been given room with makeRoom.
+func (v *values[T]) put(key string, value T, front bool) {
+	v.table.put(key, value, v.unnamed.next(front), front)
+}
+
+func (v *values[T]) remove(key string) bool {
+	if !v.table.remove(key) {
+		return false
+	}
+	atomic.AddInt64(v.keys, -1)
+	v.charges.forget(key)
+	return true
+}
+
+// evict removes an arbitrary key and reports whether there was one.
+func (v *values[T]) evict() bool {
+	key, ok := v.table.some()
+	if ok {
+		v.remove(key)
+	}
+	return ok
+}
+
+// makeRoom makes sure key can be stored in sh without exceeding the
+// eviction limit or the budgets of the tiers.
+func (s *valueStore[T]) makeRoom(sh *shard, key string) error {
+	if s.in(sh).table.has(key) {
+		return nil
+	}
+	evict := func(sh *shard) bool { return s.in(sh).evict() }
+	for s.c.tiers != nil && s.c.tiers.full() {
+		if s.c.eviction.Policy != EvictionRandom || !s.c.evict(sh, evict) {
+			return ErrCacheFull
+		}
+	}
+	return s.c.reserve(sh, &s.keys, evict)
+}
+
+func (s *valueStore[T]) Add(key string, value T) error {
+	return s.add(key, value, false)
+}
+
+func (s *valueStore[T]) Unshift(key string, value T) error {
+	return s.add(key, value, true)
+}
+
+func (s *valueStore[T]) add(key string, value T, front bool) error {
+	sh := s.c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	v := s.in(sh)
+	if s.unnamed.handOff(Item[T]{key, value}) {
+		v.remove(key)
+		return nil
+	}
+	return s.store(sh, key, value, front)
+}
+
+// store puts a key that isn't handed off, taking the quota and room it
+// needs. sh is the locked shard of key.
+func (s *valueStore[T]) store(sh *shard, key string, value T, front bool) error {
+	v := s.in(sh)
+	if err := v.charges.charge(key, s.size(key, value)); err != nil {
+		return err
+	}
+	if err := s.makeRoom(sh, key); err != nil {
+		// only new keys need room
+		v.charges.forget(key)
+		return err
+	}
+	v.put(key, value, front)
+	return nil
+}
+
+// replace sets the value of key without moving it in the unnamed list. A
+// new key is added like Add does. sh is the locked shard of key.
+func (s *valueStore[T]) replace(sh *shard, key string, value T) error {
+	v := s.in(sh)
+	if v.table.has(key) {
+		if err := v.charges.charge(key, s.size(key, value)); err != nil {
+			return err
+		}
+		v.table.set(key, value)
+		return nil
+	}
+	if s.unnamed.handOff(Item[T]{key, value}) {
+		return nil
+	}
+	return s.store(sh, key, value, false)
+}
+
+func (s *valueStore[T]) Get(key string) (T, error) {
+	sh := s.c.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	value, exists, err := s.in(sh).table.get(key)
+	switch {
+	case err != nil:
+		return value, err
+	case !exists:
+		return value, ErrNoKey
+	}
+	return value, nil
+}
+
+func (s *valueStore[T]) Delete(key string) bool {
+	sh := s.c.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return s.in(sh).remove(key)
+}
+
+func (s *valueStore[T]) Shift(list string, count int64) ([]Item[T], error) {
+	return s.take(list, count, true)
+}
+
+func (s *valueStore[T]) Pop(list string, count int64) ([]Item[T], error) {
+	return s.take(list, count, false)
+}
+
+// take removes elements from the front of a list if front is set, from the
+// back otherwise.
+func (s *valueStore[T]) take(list string, count int64, front bool) ([]Item[T], error) {
+	n, err := popCount(count)
+	if err != nil {
+		return nil, err
+	}
+	defer s.c.lockList(list)()
+	return s.pull(list, front, n)
+}
+
+// pull does the work of take with the list locked by lockList.
+func (s *valueStore[T]) pull(name string, front bool, n int) ([]Item[T], error) {
+	if name == "" {
+		items, err := s.takeKeys(front, n)
+		if len(items) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			return nil, ErrEmptyList
+		}
+		return items, nil
+	}
+	list, err := s.named(name)
+	if err != nil {
+		return nil, err
+	}
+	if n > list.Len() {
+		n = list.Len()
+	}
+	items := make([]Item[T], 0, n)
+	var size int64
+	for i := 0; i < n; i++ {
+		var value T
+		if front {
+			value = list.PopFront()
+		} else {
+			value = list.PopBack()
+		}
+		items = append(items, Item[T]{Value: value})
+		size += s.elemSize(value)
+	}
+	s.in(s.c.shard(name)).listCharges.shrink(name, size)
+	s.tidy(name)
+	return items, nil
+}
+
+// takeKeys removes up to n keys from the unnamed list. It stops early at a
+// value that can't be read, returning the error. Every shard must be locked.
+func (s *valueStore[T]) takeKeys(front bool, n int) ([]Item[T], error) {
+	u := newUnnamed(s.tables())
+	var items []Item[T]
+	for len(items) < n {
+		i := u.first(front)
+		if i < 0 {
+			break
+		}
+		it := u.tables[i].iter(!front)
+		key, value, _, ok := it.next()
+		if !ok {
+			return items, it.err()
+		}
+		items = append(items, Item[T]{key, value})
+		s.in(s.c.shards[i]).remove(key)
+	}
+	return items, nil
+}
+
+// tables returns the table of each shard.
+func (s *valueStore[T]) tables() []table[T] {
+	tables := make([]table[T], len(s.c.shards))
+	for i, sh := range s.c.shards {
+		tables[i] = s.in(sh).table
+	}
+	return tables
+}
+
+func (s *valueStore[T]) Push(list string, value T) error {
+	if list == "" {
+		return ErrNoListName
+	}
+	sh := s.c.shard(list)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	v := s.in(sh)
+	if v.waiters.handOff(list, Item[T]{Value: value}) {
+		return nil
+	}
+	l, exists := v.lists[list]
+	if exists {
+		if err := s.c.checkLen(list, l.Len()); err != nil {
+			return err
+		}
+	}
+	if err := v.listCharges.grow(list, s.elemSize(value)); err != nil {
+		return err
+	}
+	if !exists {
+		l = newDeque[T]()
+		v.lists[list] = l
+	}
+	l.PushBack(value)
+	return nil
+}
+
+func (s *valueStore[T]) DeleteList(list string) (bool, error) {
+	if list == "" {
+		return false, ErrNoListName
+	}
+	sh := s.c.shard(list)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	v := s.in(sh)
+	_, exists := v.lists[list]
+	delete(v.lists, list)
+	v.listCharges.forget(list)
+	return exists, nil
+}
+
+func (s *valueStore[T]) BlockingShift(ctx context.Context, list string, timeout time.Duration) (Item[T], bool, error) {
+	return s.blockingTake(ctx, list, timeout, true)
+}
+
+func (s *valueStore[T]) BlockingPop(ctx context.Context, list string, timeout time.Duration) (Item[T], bool, error) {
+	return s.blockingTake(ctx, list, timeout, false)
+}
+
+func (s *valueStore[T]) Waiting(list string) int {
+	if list == "" {
+		return s.unnamed.waitingCalls()
+	}
+	sh := s.c.shard(list)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return len(s.in(sh).waiters[list])
+}
+
+func (s *valueStore[T]) blockingTake(ctx context.Context, list string, timeout time.Duration, front bool) (Item[T], bool, error) {
+	if timeout < 0 {
+		return Item[T]{}, false, errNegativeTimeout
+	}
+	unlock := s.c.lockList(list)
+	items, err := s.pull(list, front, 1)
+	switch {
+	case err == nil:
+		unlock()
+		return items[0], true, nil
+	case !nothingToTake(err):
+		unlock()
+		return Item[T]{}, false, err
+	}
+	w, remove := s.c.addWaiter(list, &s.unnamed, func(sh *shard) waitQueues { return s.in(sh).waiters })
+	unlock()
+
+	item, err := wait(ctx, w, remove, timeout)
+	if err != nil || item == nil {
+		return Item[T]{}, false, err
+	}
+	return item.(Item[T]), true, nil
+}
+
+// nothingToTake reports whether err only says that a list is empty or
+// doesn't exist, which blocking calls wait out.
+func nothingToTake(err error) bool {
+	e, ok := err.(*Error)
+	return err == ErrEmptyList || ok && e.Reason == "LIST_NOT_FOUND"
+}
+
+// view returns the named list, or the unnamed one for "", for reading. The
+// list must be locked by rlockList.
+func (s *valueStore[T]) view(name string) (listView[T], error) {
+	if name == "" {
+		u := newUnnamed(s.tables())
+		u.index = &s.index
+		return u, nil
+	}
+	return s.named(name)
+}
+
+// named returns a named list. Its shard must be locked.
+func (s *valueStore[T]) named(name string) (*deque[T], error) {
+	list, exists := s.in(s.c.shard(name)).lists[name]
+	if !exists {
+		return nil, &Error{NotFound, fmt.Sprintf("no list %q", name), "LIST_NOT_FOUND"}
+	}
+	return list, nil
+}
+
+// checkLen returns an error if a named list of n elements can't grow.
+func (c *Cache) checkLen(list string, n int) error {
+	if c.limits.MaxListLength > 0 && n >= c.limits.MaxListLength {
+		return &Error{InvalidArgument, fmt.Sprintf("list %q is full at %d elements", list, n), "LIST_TOO_LONG"}
+	}
+	if c.quotas != nil {
+		return c.quotas.checkList(list, n)
+	}
+	return nil
+}
+
+// tidy drops a named list once it is empty. The list must exist.
+func (s *valueStore[T]) tidy(name string) {
+	v := s.in(s.c.shard(name))
+	if v.lists[name].Len() == 0 {
+		delete(v.lists, name)
+		v.listCharges.forget(name)
+	}
+}
+
+// write locks the shard of a named list for a change and returns the list.
+func (s *valueStore[T]) write(name string) (*deque[T], func(), error) {
+	if name == "" {
+		return nil, nil, ErrNoListName
+	}
+	sh := s.c.shard(name)
+	sh.mu.Lock()
+	list, err := s.named(name)
+	if err != nil {
+		sh.mu.Unlock()
+		return nil, nil, err
+	}
+	return list, sh.mu.Unlock, nil
+}
+
+func (s *valueStore[T]) ListRange(list string, start, stop int64) ([]T, error) {
+	defer s.c.rlockList(list)()
+	l, err := s.view(list)
+	if err != nil {
+		return nil, err
+	}
+	from, to := span(start, stop, l.Len())
+	values := l.Slice(from, to)
+	if u, ok := l.(unnamed[T]); ok && *u.err != nil {
+		return nil, *u.err
+	}
+	return values, nil
+}
+
+func (s *valueStore[T]) ListIndex(list string, index int64) (T, error) {
+	defer s.c.rlockList(list)()
+	var zero T
+	l, err := s.view(list)
+	if err != nil {
+		return zero, err
+	}
+	i, ok := position(index, l.Len())
+	if !ok {
+		return zero, ErrIndex
+	}
+	value := l.At(i)
+	if u, ok := l.(unnamed[T]); ok && *u.err != nil {
+		return zero, *u.err
+	}
+	return value, nil
+}
+
+func (s *valueStore[T]) ListSet(list string, index int64, value T) error {
+	l, unlock, err := s.write(list)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	i, ok := position(index, l.Len())
+	if !ok {
+		return ErrIndex
+	}
+	if err := s.in(s.c.shard(list)).listCharges.grow(list, s.elemSize(value)-s.elemSize(l.At(i))); err != nil {
+		return err
+	}
+	l.Set(i, value)
+	return nil
+}
+
+func (s *valueStore[T]) ListInsert(list string, pivot, value T, after bool) (int, error) {
+	l, unlock, err := s.write(list)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	if err := s.c.checkLen(list, l.Len()); err != nil {
+		return 0, err
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !s.t.equal(l.At(i), pivot) {
+			continue
+		}
+		if after {
+			i++
+		}
+		if err := s.in(s.c.shard(list)).listCharges.grow(list, s.elemSize(value)); err != nil {
+			return 0, err
+		}
+		l.Insert(i, value)
+		return l.Len(), nil
+	}
+	return 0, errNoPivot
+}
+
+func (s *valueStore[T]) ListTrim(list string, start, stop int64) error {
+	l, unlock, err := s.write(list)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	from, to := span(start, stop, l.Len())
+	var size int64
+	for l.Len() > to {
+		size += s.elemSize(l.PopBack())
+	}
+	for i := 0; i < from; i++ {
+		size += s.elemSize(l.PopFront())
+	}
+	s.in(s.c.shard(list)).listCharges.shrink(list, size)
+	s.tidy(list)
+	return nil
+}
+
+func (s *valueStore[T]) ListRemove(list string, count int64, value T) (int, error) {
+	l, unlock, err := s.write(list)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	limit := count
+	if limit < 0 {
+		limit = -limit
+	}
+	n := l.Len()
+	drop := make([]bool, n)
+	removed := 0
+	var size int64
+	for j := 0; j < n && (limit == 0 || int64(removed) < limit); j++ {
+		i := j
+		if count < 0 {
+			i = n - 1 - j
+		}
+		if s.t.equal(l.At(i), value) {
+			drop[i] = true
+			removed++
+			size += s.elemSize(l.At(i))
+		}
+	}
+	l.Filter(func(i int, v T) bool { return !drop[i] })
+	s.in(s.c.shard(list)).listCharges.shrink(list, size)
+	s.tidy(list)
+	return removed, nil
+}
+
+func (s *valueStore[T]) ListLen(list string) (int, error) {
+	defer s.c.rlockList(list)()
+	l, err := s.view(list)
+	if err != nil {
+		return 0, err
+	}
+	return l.Len(), nil
+}
+
+// lists returns the named lists of sh.
+func (s *valueStore[T]) lists(sh *shard) []ListInfo {
+	var res []ListInfo
+	for name, list := range s.in(sh).lists {
+		res = append(res, ListInfo{name, s.t.Name, list.Len()})
+	}
+	return res
+}
+
+// valueSnapshot is the on-disk form of a valueStore.
+type valueSnapshot[T any] struct {
+	Items map[string]T
+	// keys in the order of the unnamed list
+	Keys  []string
+	Lists map[string][]T
+}
+
+func (s *valueStore[T]) save(shards []*shard) ([]byte, error) {
+	snap := valueSnapshot[T]{Items: map[string]T{}, Lists: map[string][]T{}}
+	tables := make([]table[T], len(shards))
+	for i, sh := range shards {
+		v := s.in(sh)
+		tables[i] = v.table
+		for name, list := range v.lists {
+			snap.Lists[name] = list.Values()
+		}
+	}
+	if err := newUnnamed(tables).walk(false, func(table int, key string, value T) bool {
+		snap.Keys = append(snap.Keys, key)
+		snap.Items[key] = value
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(snap); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// load fills shards, which are not in use yet, from a snapshot made by save.
+func (s *valueStore[T]) load(shards []*shard, b []byte) error {
+	var snap valueSnapshot[T]
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&snap); err != nil {
+		return err
+	}
+	for _, key := range snap.Keys {
+		s.in(shards[s.c.index(key)]).put(key, snap.Items[key], false)
+	}
+	for name, list := range snap.Lists {
+		s.in(shards[s.c.index(name)]).lists[name] = newDeque(list...)
+	}
+	return nil
+}
+
+func (s *valueStore[T]) adopt(sh, old *shard) {
+	s.in(sh).waiters = s.in(old).waiters
+}
+
+func (s *valueStore[T]) release(sh *shard) error {
+	// the index must not keep the tables of replaced shards alive
+	s.index.reset()
+	if t, ok := s.in(sh).table.(*tieredTable[T]); ok {
+		return t.close()
+	}
+	return nil
+}
+
+// count sets the number of keys, and their usage with quotas, from the
+// contents of shards.
+func (s *valueStore[T]) count(shards []*shard) {
+	n := 0
+	for _, sh := range shards {
+		n += s.in(sh).table.len()
+	}
+	atomic.StoreInt64(&s.keys, int64(n))
+	if s.c.quotas != nil {
+		s.recount(shards)
+	}
+}
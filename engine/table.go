@@ -0,0 +1,182 @@
+package engine
+
+// table holds the keys of one type in a shard, with their values and their
+// place in the unnamed list. Keys are kept in the order of their positions.
+type table[T any] interface {
+	// get returns the value of key and whether it is stored. An error
+	// means the value couldn't be read.
+	get(key string) (T, bool, error)
+	// peek returns the value of a stored key like get, without counting it
+	// as used.
+	peek(key string) (T, error)
+	has(key string) bool
+	// put stores key at pos, which is in front of every key if front is
+	// set and behind every key otherwise. A stored key moves there.
+	put(key string, value T, pos int64, front bool)
+	// set replaces the value of a stored key, keeping its place.
+	set(key string, value T)
+	// remove deletes key and reports whether it was stored.
+	remove(key string) bool
+	len() int
+	// some returns an arbitrary key, for eviction.
+	some() (string, bool)
+	// edge returns the position of the first key in the list, or the last
+	// one if front isn't set, and false if there are no keys.
+	edge(front bool) (int64, bool)
+	// iter returns an iterator over the keys in list order, or in reverse
+	// order if back is set.
+	iter(back bool) tableIter[T]
+	// version changes whenever a key is put or removed, so an index of the
+	// unnamed list can tell it is stale.
+	version() uint64
+}
+
+// tableIter walks the keys of a table, which must not change meanwhile.
+// next returns false after the last key or when a value couldn't be read,
+// which err then returns.
+type tableIter[T any] interface {
+	next() (key string, value T, pos int64, ok bool)
+	err() error
+}
+
+// indexed is implemented by tables that can read the value at an index of
+// their part of the unnamed list directly while they are dense.
+type indexed[T any] interface {
+	dense() bool
+	at(i int) T
+}
+
+type item[T any] struct {
+	Value T
+	entry *entry
+}
+
+// mapTable is the table of a Cache: a map of the keys and a deque of
+// entries for the order.
+type mapTable[T any] struct {
+	items   map[string]item[T]
+	list    *deque[*entry]
+	changes uint64
+}
+
+func newMapTable[T any]() *mapTable[T] {
+	return &mapTable[T]{items: map[string]item[T]{}, list: newDeque[*entry]()}
+}
+
+func (t *mapTable[T]) get(key string) (T, bool, error) {
+	item, exists := t.items[key]
+	return item.Value, exists, nil
+}
+
+func (t *mapTable[T]) peek(key string) (T, error) {
+	return t.items[key].Value, nil
+}
+
+func (t *mapTable[T]) has(key string) bool {
+	_, exists := t.items[key]
+	return exists
+}
+
+func (t *mapTable[T]) put(key string, value T, pos int64, front bool) {
+	if item, exists := t.items[key]; exists {
+		item.entry.dead = true
+	}
+	t.changes++
+	e := &entry{key: key, pos: pos}
+	t.items[key] = item[T]{value, e}
+	if front {
+		t.list.PushFront(e)
+	} else {
+		t.list.PushBack(e)
+	}
+	compact(t.list, len(t.items))
+}
+
+func (t *mapTable[T]) set(key string, value T) {
+	item := t.items[key]
+	item.Value = value
+	t.items[key] = item
+}
+
+// remove marks the element of key in the unnamed list as dead, so it
+// doesn't have to be searched for.
+func (t *mapTable[T]) remove(key string) bool {
+	item, exists := t.items[key]
+	if !exists {
+		return false
+	}
+	t.changes++
+	delete(t.items, key)
+	item.entry.dead = true
+	compact(t.list, len(t.items))
+	return true
+}
+
+func (t *mapTable[T]) len() int {
+	return len(t.items)
+}
+
+func (t *mapTable[T]) some() (string, bool) {
+	for key := range t.items {
+		return key, true
+	}
+	return "", false
+}
+
+// edge relies on compact, which keeps the ends of the list live.
+func (t *mapTable[T]) edge(front bool) (int64, bool) {
+	if t.list.Len() == 0 {
+		return 0, false
+	}
+	if front {
+		return t.list.At(0).pos, true
+	}
+	return t.list.At(t.list.Len() - 1).pos, true
+}
+
+// dense reports whether the list has no dead entries.
+func (t *mapTable[T]) dense() bool {
+	return t.list.Len() == len(t.items)
+}
+
+func (t *mapTable[T]) at(i int) T {
+	return t.items[t.list.At(i).key].Value
+}
+
+func (t *mapTable[T]) version() uint64 {
+	return t.changes
+}
+
+func (t *mapTable[T]) iter(back bool) tableIter[T] {
+	it := &mapIter[T]{t: t, back: back}
+	if back {
+		it.i = t.list.Len() - 1
+	}
+	return it
+}
+
+type mapIter[T any] struct {
+	t    *mapTable[T]
+	i    int
+	back bool
+}
+
+func (it *mapIter[T]) next() (string, T, int64, bool) {
+	for it.i >= 0 && it.i < it.t.list.Len() {
+		e := it.t.list.At(it.i)
+		if it.back {
+			it.i--
+		} else {
+			it.i++
+		}
+		if !e.dead {
+			return e.key, it.t.items[e.key].Value, e.pos, true
+		}
+	}
+	var zero T
+	return "", zero, 0, false
+}
+
+func (it *mapIter[T]) err() error {
+	return nil
+}
@@ -0,0 +1,545 @@
+package engine
+
+import (
+	"container/list"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+)
+
+// With tiers, the keys of the value types live in memory until the memory
+// budget is used up, and the least recently used ones then move to a log
+// file per table on disk, as long as the disk budget allows. Get reads a key
+// on disk back into memory. The budgets count the bytes of keys and encoded
+// values over all tables; a table only moves its own keys, so the memory
+// tier can go over its budget until the other tables are written to. Named
+// lists and the other types stay in memory.
+
+// Tiers configures WithTiers.
+type Tiers struct {
+	// Dir holds the files of the disk tier and must be set. It must not be
+	// shared with another Cache, as files left in it are removed.
+	Dir string
+	// Memory and Disk are the budgets of the tiers in bytes.
+	Memory, Disk int64
+}
+
+// WithTiers moves keys that haven't been read or written recently to disk.
+// Once both tiers are full, new keys are rejected or evicted as with
+// MaxKeys.
+func WithTiers(t Tiers) Option {
+	return func(c *Cache) {
+		c.tiers = &tierState{Tiers: t}
+	}
+}
+
+// TierStats describes a tier. Hits and misses count Get calls, the reads
+// of the unnamed list aren't counted.
+type TierStats struct {
+	// Name is "memory" or "disk".
+	Name   string
+	Keys   int64
+	Bytes  int64
+	Budget int64
+	Hits   int64
+	Misses int64
+}
+
+// tierState is what the tables of a Cache share. The counts are changed
+// atomically.
+type tierState struct {
+	Tiers
+	// number of log files created, for their names
+	files      int64
+	mem, disk  tierCounts
+	removeOnce sync.Once
+}
+
+type tierCounts struct {
+	keys, bytes, hits, misses int64
+}
+
+func (c *tierCounts) add(keys, bytes int64) {
+	atomic.AddInt64(&c.keys, keys)
+	atomic.AddInt64(&c.bytes, bytes)
+}
+
+func (c *tierCounts) stats(name string, budget int64) TierStats {
+	return TierStats{
+		Name:   name,
+		Keys:   atomic.LoadInt64(&c.keys),
+		Bytes:  atomic.LoadInt64(&c.bytes),
+		Budget: budget,
+		Hits:   atomic.LoadInt64(&c.hits),
+		Misses: atomic.LoadInt64(&c.misses),
+	}
+}
+
+// full reports whether the memory tier is over its budget and the disk tier
+// has no room left.
+func (s *tierState) full() bool {
+	return atomic.LoadInt64(&s.mem.bytes) > s.Memory && atomic.LoadInt64(&s.disk.bytes) >= s.Disk
+}
+
+// removeStale removes the log files of an earlier run, once.
+func (s *tierState) removeStale() {
+	s.removeOnce.Do(func() {
+		files, _ := filepath.Glob(filepath.Join(s.Dir, "*.tier"))
+		for _, f := range files {
+			os.Remove(f)
+		}
+	})
+}
+
+// Tiers implements Engine. It returns nil without WithTiers.
+func (c *Cache) Tiers() []TierStats {
+	if c.tiers == nil {
+		return nil
+	}
+	return []TierStats{
+		c.tiers.mem.stats("memory", c.tiers.Memory),
+		c.tiers.disk.stats("disk", c.tiers.Disk),
+	}
+}
+
+// Close implements Engine. It removes the files of the disk tier, after
+// which the Cache must not be used.
+func (c *Cache) Close() error {
+	defer c.lockAll()()
+	return c.release(c.shards)
+}
+
+// release closes the tables of shards that are no longer used.
+func (c *Cache) release(shards []*shard) error {
+	var first error
+	for _, sh := range shards {
+		for _, s := range c.stores {
+			if err := s.release(sh); err != nil && first == nil {
+				first = err
+			}
+		}
+	}
+	return first
+}
+
+type tieredItem[T any] struct {
+	// the value while it is in memory
+	value T
+	entry *entry
+	// bytes of the key and the encoded value
+	size int64
+	// place in the LRU list while the value is in memory, nil while it is on
+	// disk at off
+	elem *list.Element
+	off  int64
+}
+
+// tieredTable is the table of a Cache with tiers. Get reads keys in from
+// disk with only a read lock on the shard, so the table has its own lock.
+// The keys are ordered like those of a mapTable.
+type tieredTable[T any] struct {
+	mu    sync.Mutex
+	items map[string]*tieredItem[T]
+	list  *deque[*entry]
+	// keys in memory, most recently used first
+	lru   *list.List
+	codec *Codec[T]
+	tiers *tierState
+	log   tierLog
+	// changed with the shard locked for writing, so read without mu
+	changes uint64
+}
+
+func newTieredTable[T any](codec *Codec[T], tiers *tierState, name string) *tieredTable[T] {
+	tiers.removeStale()
+	n := atomic.AddInt64(&tiers.files, 1)
+	return &tieredTable[T]{
+		items: map[string]*tieredItem[T]{},
+		list:  newDeque[*entry](),
+		lru:   list.New(),
+		codec: codec,
+		tiers: tiers,
+		log:   tierLog{path: filepath.Join(tiers.Dir, fmt.Sprintf("%s-%d.tier", name, n))},
+	}
+}
+
+func (t *tieredTable[T]) get(key string) (T, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var zero T
+	it, exists := t.items[key]
+	if !exists {
+		atomic.AddInt64(&t.tiers.mem.misses, 1)
+		atomic.AddInt64(&t.tiers.disk.misses, 1)
+		return zero, false, nil
+	}
+	if it.elem != nil {
+		atomic.AddInt64(&t.tiers.mem.hits, 1)
+		t.lru.MoveToFront(it.elem)
+		return it.value, true, nil
+	}
+	atomic.AddInt64(&t.tiers.mem.misses, 1)
+	value, err := t.read(key, it)
+	if err != nil {
+		return zero, true, err
+	}
+	atomic.AddInt64(&t.tiers.disk.hits, 1)
+	t.drop(it)
+	t.warm(key, it, value)
+	t.compactLog()
+	t.spill()
+	return value, true, nil
+}
+
+// peek reads a value on disk without moving it to memory, like iter.
+func (t *tieredTable[T]) peek(key string) (T, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	it := t.items[key]
+	if it.elem != nil {
+		return it.value, nil
+	}
+	return t.read(key, it)
+}
+
+func (t *tieredTable[T]) has(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, exists := t.items[key]
+	return exists
+}
+
+func (t *tieredTable[T]) put(key string, value T, pos int64, front bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.changes++
+	if it, exists := t.items[key]; exists {
+		it.entry.dead = true
+		t.drop(it)
+	}
+	e := &entry{key: key, pos: pos}
+	it := &tieredItem[T]{entry: e}
+	t.items[key] = it
+	t.warm(key, it, value)
+	t.compactLog()
+	if front {
+		t.list.PushFront(e)
+	} else {
+		t.list.PushBack(e)
+	}
+	compact(t.list, len(t.items))
+	t.spill()
+}
+
+func (t *tieredTable[T]) set(key string, value T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	it := t.items[key]
+	t.drop(it)
+	t.warm(key, it, value)
+	t.compactLog()
+	t.spill()
+}
+
+func (t *tieredTable[T]) remove(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	it, exists := t.items[key]
+	if !exists {
+		return false
+	}
+	t.changes++
+	delete(t.items, key)
+	it.entry.dead = true
+	t.drop(it)
+	t.compactLog()
+	compact(t.list, len(t.items))
+	return true
+}
+
+// warm keeps value in memory as the most recently used one.
+func (t *tieredTable[T]) warm(key string, it *tieredItem[T], value T) {
+	it.value = value
+	it.size = int64(len(key) + len(t.codec.Append(nil, value)))
+	it.elem = t.lru.PushFront(key)
+	t.tiers.mem.add(1, it.size)
+}
+
+// drop takes the value of it out of its tier. A record it leaves in the log
+// is dead, but compactLog would still copy it while it is in t.items and not
+// in memory, so callers compact only after removing or warming it.
+func (t *tieredTable[T]) drop(it *tieredItem[T]) {
+	if it.elem != nil {
+		t.lru.Remove(it.elem)
+		it.elem = nil
+		t.tiers.mem.add(-1, -it.size)
+		return
+	}
+	t.tiers.disk.add(-1, -it.size)
+	t.log.dead += recordSize(it.size)
+}
+
+// spill moves the least recently used values of the table to disk while
+// the memory tier is over its budget and the disk tier has room. Values
+// that can't be written stay in memory.
+func (t *tieredTable[T]) spill() {
+	for atomic.LoadInt64(&t.tiers.mem.bytes) > t.tiers.Memory {
+		back := t.lru.Back()
+		if back == nil {
+			return
+		}
+		key := back.Value.(string)
+		it := t.items[key]
+		if atomic.AddInt64(&t.tiers.disk.bytes, it.size) > t.tiers.Disk {
+			atomic.AddInt64(&t.tiers.disk.bytes, -it.size)
+			return
+		}
+		off, err := t.log.write(key, t.codec.Append(nil, it.value))
+		if err != nil {
+			atomic.AddInt64(&t.tiers.disk.bytes, -it.size)
+			return
+		}
+		atomic.AddInt64(&t.tiers.disk.keys, 1)
+		t.lru.Remove(back)
+		t.tiers.mem.add(-1, -it.size)
+		var zero T
+		it.value, it.elem, it.off = zero, nil, off
+	}
+}
+
+// read returns the value of a key on disk.
+func (t *tieredTable[T]) read(key string, it *tieredItem[T]) (T, error) {
+	var zero T
+	k, v, err := t.log.read(it.off, it.size)
+	if err != nil {
+		return zero, err
+	}
+	if string(k) != key {
+		return zero, errorf(DataLoss, "disk tier: found key %q instead of %q in %s", k, key, t.log.path)
+	}
+	return t.codec.Decode(v), nil
+}
+
+// compactLog rewrites the log without removed records once they make up
+// half of it. The old log is kept if that fails.
+func (t *tieredTable[T]) compactLog() {
+	l := &t.log
+	if l.dead < minCompact || l.dead*2 < l.size {
+		return
+	}
+	tmp := l.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return
+	}
+	var size int64
+	offs := map[*tieredItem[T]]int64{}
+	for _, it := range t.items {
+		if it.elem != nil {
+			continue
+		}
+		rec := make([]byte, recordSize(it.size))
+		if _, err = l.f.ReadAt(rec, it.off); err != nil {
+			break
+		}
+		if _, err = f.WriteAt(rec, size); err != nil {
+			break
+		}
+		offs[it] = size
+		size += int64(len(rec))
+	}
+	if err == nil {
+		err = os.Rename(tmp, l.path)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return
+	}
+	l.f.Close()
+	l.f, l.size, l.dead = f, size, 0
+	for it, off := range offs {
+		it.off = off
+	}
+}
+
+// close removes the log and takes the keys out of the tier counts.
+func (t *tieredTable[T]) close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, it := range t.items {
+		if it.elem != nil {
+			t.tiers.mem.add(-1, -it.size)
+		} else {
+			t.tiers.disk.add(-1, -it.size)
+		}
+	}
+	t.items = map[string]*tieredItem[T]{}
+	t.list = newDeque[*entry]()
+	t.lru.Init()
+	return t.log.close()
+}
+
+func (t *tieredTable[T]) len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.items)
+}
+
+// some returns the least recently used key in memory, or any key if all of
+// them are on disk.
+func (t *tieredTable[T]) some() (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if back := t.lru.Back(); back != nil {
+		return back.Value.(string), true
+	}
+	for key := range t.items {
+		return key, true
+	}
+	return "", false
+}
+
+func (t *tieredTable[T]) edge(front bool) (int64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.list.Len() == 0 {
+		return 0, false
+	}
+	if front {
+		return t.list.At(0).pos, true
+	}
+	return t.list.At(t.list.Len() - 1).pos, true
+}
+
+func (t *tieredTable[T]) version() uint64 {
+	return t.changes
+}
+
+// iter reads values on disk without moving them to memory.
+func (t *tieredTable[T]) iter(back bool) tableIter[T] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	it := &tieredIter[T]{t: t, back: back}
+	if back {
+		it.i = t.list.Len() - 1
+	}
+	return it
+}
+
+type tieredIter[T any] struct {
+	t    *tieredTable[T]
+	i    int
+	back bool
+	e    error
+}
+
+func (it *tieredIter[T]) next() (string, T, int64, bool) {
+	t := it.t
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var zero T
+	for it.e == nil && it.i >= 0 && it.i < t.list.Len() {
+		e := t.list.At(it.i)
+		if it.back {
+			it.i--
+		} else {
+			it.i++
+		}
+		if e.dead {
+			continue
+		}
+		item := t.items[e.key]
+		if item.elem != nil {
+			return e.key, item.value, e.pos, true
+		}
+		value, err := t.read(e.key, item)
+		if err != nil {
+			it.e = err
+			break
+		}
+		return e.key, value, e.pos, true
+	}
+	return "", zero, 0, false
+}
+
+func (it *tieredIter[T]) err() error {
+	return it.e
+}
+
+// A record of a log is a header of the CRC-32 of the rest of the record and
+// the lengths of the key and the value, followed by the key and the value.
+const tierHeader = 12
+
+func recordSize(size int64) int64 {
+	return tierHeader + size
+}
+
+// tierLog is the file of a table's disk tier. Records are appended, and
+// removed ones stay until the table compacts the log.
+type tierLog struct {
+	path string
+	// opened on the first write
+	f *os.File
+	// end of the last record and bytes of removed records
+	size, dead int64
+}
+
+// write appends a record and returns its offset.
+func (l *tierLog) write(key string, value []byte) (int64, error) {
+	if l.f == nil {
+		if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+			return 0, err
+		}
+		f, err := os.OpenFile(l.path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return 0, err
+		}
+		l.f = f
+	}
+	rec := make([]byte, tierHeader, tierHeader+len(key)+len(value))
+	binary.LittleEndian.PutUint32(rec[4:], uint32(len(key)))
+	binary.LittleEndian.PutUint32(rec[8:], uint32(len(value)))
+	rec = append(append(rec, key...), value...)
+	binary.LittleEndian.PutUint32(rec, crc32.ChecksumIEEE(rec[4:]))
+	if _, err := l.f.WriteAt(rec, l.size); err != nil {
+		return 0, err
+	}
+	off := l.size
+	l.size += int64(len(rec))
+	return off, nil
+}
+
+// read returns the key and the value of the record at off, whose key and
+// value take size bytes.
+func (l *tierLog) read(off, size int64) (key, value []byte, err error) {
+	if l.f == nil {
+		return nil, nil, errorf(DataLoss, "disk tier: %s is not open", l.path)
+	}
+	rec := make([]byte, recordSize(size))
+	if _, err := l.f.ReadAt(rec, off); err != nil {
+		return nil, nil, errorf(DataLoss, "disk tier: %v", err)
+	}
+	k := binary.LittleEndian.Uint32(rec[4:])
+	if binary.LittleEndian.Uint32(rec) != crc32.ChecksumIEEE(rec[4:]) || int64(k) > size {
+		return nil, nil, errorf(DataLoss, "disk tier: corrupt record at %d in %s", off, l.path)
+	}
+	return rec[tierHeader : tierHeader+k], rec[tierHeader+k:], nil
+}
+
+// close closes and removes the file.
+func (l *tierLog) close() error {
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	if rmErr := os.Remove(l.path); err == nil {
+		err = rmErr
+	}
+	l.f, l.size, l.dead = nil, 0, 0
+	return err
+}
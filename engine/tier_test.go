@@ -0,0 +1,139 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTieredMatchesMap(t *testing.T) {
+	tiers := &tierState{Tiers: Tiers{Dir: t.TempDir(), Memory: 4 << 10, Disk: 1 << 30}}
+	table := newTieredTable(&stringCodec, tiers, "string")
+	matchesMap(t, table)
+	if tiers.disk.keys == 0 {
+		t.Error("expected keys on disk")
+	}
+	if err := table.close(); err != nil {
+		t.Fatal(err)
+	}
+	if tiers.mem != (tierCounts{hits: tiers.mem.hits, misses: tiers.mem.misses}) || tiers.disk.keys != 0 || tiers.disk.bytes != 0 {
+		t.Errorf("expected a closed table to leave no keys, got %+v and %+v", tiers.mem, tiers.disk)
+	}
+}
+
+func TestTiersSpillAndFaultBack(t *testing.T) {
+	dir := t.TempDir()
+	c := New(WithShards(1), WithTiers(Tiers{Dir: dir, Memory: 1000, Disk: 2000}))
+	strings := Of[string](c, String)
+	value := string(make([]byte, 95))
+	// 5 bytes of key and 95 of value each
+	for i := 0; i < 25; i++ {
+		if err := strings.Add(fmt.Sprintf("k%04d", i), value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mem, disk := c.Tiers()[0], c.Tiers()[1]
+	if mem.Keys != 10 || mem.Bytes != 1000 || disk.Keys != 15 || disk.Bytes != 1500 {
+		t.Fatalf("expected 10 keys in memory and 15 on disk, got %+v and %+v", mem, disk)
+	}
+	// the oldest key is on disk and moves back to memory
+	if v, err := strings.Get("k0000"); err != nil || v != value {
+		t.Fatalf("Get of a key on disk = %q, %v", v, err)
+	}
+	if _, err := strings.Get("k0024"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := strings.Get("none"); err != ErrNoKey {
+		t.Fatalf("Get of a missing key returned %v", err)
+	}
+	mem, disk = c.Tiers()[0], c.Tiers()[1]
+	if mem.Hits != 1 || mem.Misses != 2 || disk.Hits != 1 || disk.Misses != 1 {
+		t.Fatalf("unexpected hits and misses: %+v and %+v", mem, disk)
+	}
+	if mem.Keys != 10 || disk.Keys != 15 {
+		t.Fatalf("expected the tiers to stay full, got %+v and %+v", mem, disk)
+	}
+	// the order of the unnamed list doesn't depend on the tier
+	if items, err := strings.Shift("", 1); err != nil || items[0].Key != "k0000" {
+		t.Fatalf("Shift = %v, %v, want k0000", items, err)
+	}
+
+	// with both tiers full, new keys are rejected
+	for i := 0; i < 10; i++ {
+		strings.Add(fmt.Sprint("new", i), value)
+	}
+	if err := strings.Add("more", value); err != ErrCacheFull {
+		t.Fatalf("Add with full tiers returned %v, want ErrCacheFull", err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "*")); len(files) != 0 {
+		t.Errorf("expected Close to remove the files, found %v", files)
+	}
+}
+
+func TestTierLogStaysBounded(t *testing.T) {
+	c := New(WithShards(1), WithTiers(Tiers{Dir: t.TempDir(), Memory: 1000, Disk: 1 << 30}))
+	strings := Of[string](c, String)
+	value := string(make([]byte, 95))
+	// overwrite keys and read them back, which both take them off disk
+	for i := 0; i < 20000; i++ {
+		key := fmt.Sprintf("k%04d", i%40)
+		if err := strings.Add(key, value); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := strings.Get(fmt.Sprintf("k%04d", (i+17)%40)); err != nil && err != ErrNoKey {
+			t.Fatal(err)
+		}
+	}
+	log := storeOf(c, String).in(c.shards[0]).table.(*tieredTable[string]).log
+	live := c.Tiers()[1].Bytes + c.Tiers()[1].Keys*tierHeader
+	// removed records are dropped once they are half of the log
+	if log.size-log.dead != live || log.size > 2*minCompact+2*live {
+		t.Fatalf("log of %d bytes holds %d dead and %d live bytes, want it to hold only the %d bytes on disk", log.size, log.dead, log.size-log.dead, live)
+	}
+}
+
+func TestTierLogDetectsCorruption(t *testing.T) {
+	dir := t.TempDir()
+	c := New(WithShards(1), WithTiers(Tiers{Dir: dir, Memory: 0, Disk: 1 << 20}))
+	strings := Of[string](c, String)
+	if err := strings.Add("key", "value"); err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.tier"))
+	if len(files) != 1 {
+		t.Fatalf("expected one log file, found %v", files)
+	}
+	b, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[len(b)-1] ^= 1
+	if err := os.WriteFile(files[0], b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := strings.Get("key"); !isDataLoss(err) {
+		t.Fatalf("Get of a corrupt record returned %v, want a DataLoss error", err)
+	}
+	if _, err := strings.ListRange("", 0, -1); !isDataLoss(err) {
+		t.Fatalf("ListRange over a corrupt record returned %v, want a DataLoss error", err)
+	}
+	// blocking calls report it instead of waiting for another key
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, _, err := strings.BlockingShift(ctx, "", 0); !isDataLoss(err) {
+		t.Fatalf("BlockingShift over a corrupt record returned %v, want a DataLoss error", err)
+	}
+}
+
+func isDataLoss(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Kind == DataLoss
+}
@@ -0,0 +1,336 @@
+package engine
+
+import (
+	"container/heap"
+	"sync"
+	"sync/atomic"
+)
+
+// entry is an element of the unnamed list. It refers to the key that holds
+// the value, and the key's item refers back to it, so removing a key only has
+// to mark its entry dead instead of searching the list.
+type entry struct {
+	key  string
+	dead bool
+	// place in the unnamed list over all shards
+	pos int64
+}
+
+// unnamedList is the part of an unnamed list the shards share. Each shard
+// keeps the entries of its own keys, ordered by the positions handed out
+// here, and the whole list is those entries merged by position.
+type unnamedList struct {
+	// last positions handed out at the front and at the back
+	front, back int64
+	// number of waiters, so Add can skip mu while there are none
+	waiting int64
+	mu      sync.Mutex
+	waiters waitQueues
+}
+
+// next returns a position in front of every entry if front is set, behind
+// every entry otherwise.
+func (u *unnamedList) next(front bool) int64 {
+	if front {
+		return atomic.AddInt64(&u.front, -1)
+	}
+	return atomic.AddInt64(&u.back, 1)
+}
+
+// addWaiter registers a call waiting on the list. Every shard must be locked,
+// so no Add can miss it.
+func (u *unnamedList) addWaiter() *waiter {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.waiters == nil {
+		u.waiters = waitQueues{}
+	}
+	atomic.AddInt64(&u.waiting, 1)
+	return u.waiters.add("")
+}
+
+func (u *unnamedList) removeWaiter(w *waiter) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	n := len(u.waiters[""])
+	u.waiters.remove("", w)
+	if len(u.waiters[""]) < n {
+		atomic.AddInt64(&u.waiting, -1)
+	}
+}
+
+func (u *unnamedList) waitingCalls() int {
+	return int(atomic.LoadInt64(&u.waiting))
+}
+
+// handOff gives item to the oldest call waiting on the list and reports
+// whether there was one. The shard of the item's key must be locked.
+func (u *unnamedList) handOff(item interface{}) bool {
+	if atomic.LoadInt64(&u.waiting) == 0 {
+		return false
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if !u.waiters.handOff("", item) {
+		return false
+	}
+	atomic.AddInt64(&u.waiting, -1)
+	return true
+}
+
+// compact drops dead entries from the ends of list, and from the whole list
+// once they outnumber the live ones. live is the number of live entries.
+// The cost of a full pass is paid for by the removals that left the entries
+// dead, so removal stays O(1) amortized.
+func compact(list *deque[*entry], live int) {
+	for list.Len() > live && list.At(0).dead {
+		list.PopFront()
+	}
+	for list.Len() > live && list.At(list.Len()-1).dead {
+		list.PopBack()
+	}
+	if dead := list.Len() - live; dead > chunkSize && dead > live {
+		list.Filter(func(i int, e *entry) bool { return !e.dead })
+	}
+}
+
+// listView is the read side shared by named lists and the unnamed list.
+type listView[T any] interface {
+	Len() int
+	At(i int) T
+	Slice(start, stop int) []T
+}
+
+// unnamed is a listView of the unnamed list that merges the tables of the
+// shards by position. Every shard must be locked. Values that can't be read
+// are returned as zero values, and the first error is kept in err.
+type unnamed[T any] struct {
+	tables []table[T]
+	live   int
+	err    *error
+	// index of the list to read far from its ends, nil to always merge
+	index *keyIndex[T]
+}
+
+func newUnnamed[T any](tables []table[T]) unnamed[T] {
+	live := 0
+	for _, t := range tables {
+		live += t.len()
+	}
+	return unnamed[T]{tables: tables, live: live, err: new(error)}
+}
+
+// keyIndex holds the keys of the unnamed list in order, as merged from the
+// tables at the versions it was built at. Reads far from both ends of the
+// list use it instead of merging the tables up to the place they read,
+// and build it again once a key was put or removed. Reads near an end
+// merge from that end, which costs less than building the index.
+type keyIndex[T any] struct {
+	mu       sync.Mutex
+	tables   []table[T]
+	versions []uint64
+	keys     []indexedKey
+}
+
+// indexedKey is a key of the unnamed list and the table that holds it.
+type indexedKey struct {
+	table int
+	key   string
+}
+
+// lookup returns the keys from start to stop of the list u reads, and nil
+// if they are read more cheaply by merging the tables.
+func (x *keyIndex[T]) lookup(u unnamed[T], start, stop int) []indexedKey {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if !x.current(u.tables) {
+		near := start
+		if u.live-stop < near {
+			near = u.live - stop
+		}
+		if near < u.live/4 {
+			return nil
+		}
+		keys := make([]indexedKey, 0, u.live)
+		if err := u.walk(false, func(table int, key string, value T) bool {
+			keys = append(keys, indexedKey{table, key})
+			return true
+		}); err != nil {
+			return nil
+		}
+		x.tables, x.keys = append(x.tables[:0], u.tables...), keys
+		x.versions = x.versions[:0]
+		for _, t := range u.tables {
+			x.versions = append(x.versions, t.version())
+		}
+	}
+	return x.keys[start:stop]
+}
+
+func (x *keyIndex[T]) reset() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.tables, x.versions, x.keys = nil, nil, nil
+}
+
+// current reports whether the index is one of tables as they are now.
+func (x *keyIndex[T]) current(tables []table[T]) bool {
+	if len(x.tables) != len(tables) {
+		return false
+	}
+	for i, t := range tables {
+		if x.tables[i] != t || x.versions[i] != t.version() {
+			return false
+		}
+	}
+	return true
+}
+
+func (u unnamed[T]) Len() int {
+	return u.live
+}
+
+func (u unnamed[T]) At(i int) T {
+	return u.Slice(i, i+1)[0]
+}
+
+func (u unnamed[T]) Slice(start, stop int) []T {
+	values := make([]T, 0, stop-start)
+	if t, ok := u.tables[0].(indexed[T]); ok && len(u.tables) == 1 && t.dense() {
+		for i := start; i < stop; i++ {
+			values = append(values, t.at(i))
+		}
+		return values
+	}
+	if start == stop {
+		return values
+	}
+	if u.index != nil {
+		if keys := u.index.lookup(u, start, stop); keys != nil {
+			for _, k := range keys {
+				value, err := u.tables[k.table].peek(k.key)
+				if err != nil {
+					*u.err = err
+					return make([]T, stop-start)
+				}
+				values = append(values, value)
+			}
+			return values
+		}
+	}
+	// ranges in the back half are walked from the back
+	back := start > u.live-stop
+	skip := start
+	if back {
+		skip = u.live - stop
+	}
+	if err := u.walk(back, func(table int, key string, value T) bool {
+		if skip > 0 {
+			skip--
+			return true
+		}
+		values = append(values, value)
+		return len(values) < stop-start
+	}); err != nil {
+		*u.err = err
+		return make([]T, stop-start)
+	}
+	if back {
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+	}
+	return values
+}
+
+// walk calls f on the keys in list order, or in reverse order if back is
+// set, until f returns false or a value can't be read. f also gets the
+// index of the table that holds the key.
+func (u unnamed[T]) walk(back bool, f func(table int, key string, value T) bool) error {
+	h := &cursors[T]{back: back}
+	for i, t := range u.tables {
+		c := cursor[T]{it: t.iter(back), table: i}
+		if c.next() {
+			h.cs = append(h.cs, c)
+		} else if err := c.it.err(); err != nil {
+			return err
+		}
+	}
+	heap.Init(h)
+	for len(h.cs) > 0 {
+		c := &h.cs[0]
+		if !f(c.table, c.key, c.value) {
+			return nil
+		}
+		if c.next() {
+			heap.Fix(h, 0)
+		} else if err := c.it.err(); err != nil {
+			return err
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return nil
+}
+
+// first returns the index of the table holding the first key of the list,
+// or the last one if front isn't set, and -1 if the list is empty.
+func (u unnamed[T]) first(front bool) int {
+	best := -1
+	var pos int64
+	for i, t := range u.tables {
+		p, ok := t.edge(front)
+		if ok && (best < 0 || (front && p < pos) || (!front && p > pos)) {
+			best, pos = i, p
+		}
+	}
+	return best
+}
+
+// cursor is the current key of a table iterator.
+type cursor[T any] struct {
+	it    tableIter[T]
+	table int
+	key   string
+	value T
+	pos   int64
+}
+
+// next moves c to the next key and reports whether there was one.
+func (c *cursor[T]) next() bool {
+	var ok bool
+	c.key, c.value, c.pos, ok = c.it.next()
+	return ok
+}
+
+// cursors is a heap of cursors ordered by the position of their keys,
+// highest first if back is set.
+type cursors[T any] struct {
+	cs   []cursor[T]
+	back bool
+}
+
+func (h *cursors[T]) Len() int {
+	return len(h.cs)
+}
+
+func (h *cursors[T]) Less(i, j int) bool {
+	if h.back {
+		return h.cs[i].pos > h.cs[j].pos
+	}
+	return h.cs[i].pos < h.cs[j].pos
+}
+
+func (h *cursors[T]) Swap(i, j int) {
+	h.cs[i], h.cs[j] = h.cs[j], h.cs[i]
+}
+
+func (h *cursors[T]) Push(x interface{}) {
+	h.cs = append(h.cs, x.(cursor[T]))
+}
+
+func (h *cursors[T]) Pop() interface{} {
+	c := h.cs[len(h.cs)-1]
+	h.cs = h.cs[:len(h.cs)-1]
+	return c
+}
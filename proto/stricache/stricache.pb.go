@@ -9,6 +9,9 @@ package stricache
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +23,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ValueType int32
+
+const (
+	ValueType_STRING ValueType = 0
+	ValueType_INT    ValueType = 1
+	ValueType_FLOAT  ValueType = 2
+	ValueType_BYTES  ValueType = 3
+)
+
+// Enum value maps for ValueType.
+var (
+	ValueType_name = map[int32]string{
+		0: "STRING",
+		1: "INT",
+		2: "FLOAT",
+		3: "BYTES",
+	}
+	ValueType_value = map[string]int32{
+		"STRING": 0,
+		"INT":    1,
+		"FLOAT":  2,
+		"BYTES":  3,
+	}
+)
+
+func (x ValueType) Enum() *ValueType {
+	p := new(ValueType)
+	*p = x
+	return p
+}
+
+func (x ValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_stricache_proto_enumTypes[0].Descriptor()
+}
+
+func (ValueType) Type() protoreflect.EnumType {
+	return &file_proto_stricache_proto_enumTypes[0]
+}
+
+func (x ValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValueType.Descriptor instead.
+func (ValueType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{0}
+}
+
 type StringItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -185,6 +240,63 @@ func (x *FloatItem) GetValue() float64 {
 	return 0
 }
 
+// BytesItem holds arbitrary binary data, which StringItem can't as proto
+// strings must be valid UTF-8.
+type BytesItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BytesItem) Reset() {
+	*x = BytesItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesItem) ProtoMessage() {}
+
+func (x *BytesItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesItem.ProtoReflect.Descriptor instead.
+func (*BytesItem) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BytesItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BytesItem) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 type GetKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -196,7 +308,7 @@ type GetKey struct {
 func (x *GetKey) Reset() {
 	*x = GetKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_stricache_proto_msgTypes[3]
+		mi := &file_proto_stricache_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -209,7 +321,7 @@ func (x *GetKey) String() string {
 func (*GetKey) ProtoMessage() {}
 
 func (x *GetKey) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_stricache_proto_msgTypes[3]
+	mi := &file_proto_stricache_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +334,7 @@ func (x *GetKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKey.ProtoReflect.Descriptor instead.
 func (*GetKey) Descriptor() ([]byte, []int) {
-	return file_proto_stricache_proto_rawDescGZIP(), []int{3}
+	return file_proto_stricache_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetKey) GetKey() string {
@@ -238,12 +350,14 @@ type Success struct {
 	unknownFields protoimpl.UnknownFields
 
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// removed reports whether a Delete call found anything to remove.
+	Removed bool `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
 }
 
 func (x *Success) Reset() {
 	*x = Success{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_stricache_proto_msgTypes[4]
+		mi := &file_proto_stricache_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -256,7 +370,7 @@ func (x *Success) String() string {
 func (*Success) ProtoMessage() {}
 
 func (x *Success) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_stricache_proto_msgTypes[4]
+	mi := &file_proto_stricache_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +383,7 @@ func (x *Success) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Success.ProtoReflect.Descriptor instead.
 func (*Success) Descriptor() ([]byte, []int) {
-	return file_proto_stricache_proto_rawDescGZIP(), []int{4}
+	return file_proto_stricache_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Success) GetSuccess() bool {
@@ -279,6 +393,13 @@ func (x *Success) GetSuccess() bool {
 	return false
 }
 
+func (x *Success) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
 type EmptyR struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -288,7 +409,7 @@ type EmptyR struct {
 func (x *EmptyR) Reset() {
 	*x = EmptyR{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_stricache_proto_msgTypes[5]
+		mi := &file_proto_stricache_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -301,7 +422,7 @@ func (x *EmptyR) String() string {
 func (*EmptyR) ProtoMessage() {}
 
 func (x *EmptyR) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_stricache_proto_msgTypes[5]
+	mi := &file_proto_stricache_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,228 +435,6554 @@ func (x *EmptyR) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyR.ProtoReflect.Descriptor instead.
 func (*EmptyR) Descriptor() ([]byte, []int) {
-	return file_proto_stricache_proto_rawDescGZIP(), []int{5}
+	return file_proto_stricache_proto_rawDescGZIP(), []int{6}
 }
 
-var File_proto_stricache_proto protoreflect.FileDescriptor
+// ListKey names a list. The empty name is the list fed by Add and Unshift.
+type ListKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_stricache_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a, 0x07, 0x49, 0x6e, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x1a, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x23, 0x0a, 0x07,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x08, 0x0a, 0x06, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x32, 0xdc, 0x07, 0x0a, 0x10,
-	0x53, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x39, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x49, 0x74, 0x65, 0x6d, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x06, 0x41,
-	0x64, 0x64, 0x49, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x36, 0x0a,
-	0x08, 0x41, 0x64, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a,
-	0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0d, 0x55, 0x6e, 0x73, 0x68, 0x69, 0x66, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x15, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x49, 0x74, 0x65, 0x6d, 0x12, 0x34, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x49,
-	0x6e, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49,
-	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3a, 0x0a, 0x0c, 0x55, 0x6e,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x35, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a,
-	0x06, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
-	0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x33,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x14, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x35, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
-	0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34,
-	0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x11, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79,
-	0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x68,
-	0x69, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a,
-	0x0a, 0x53, 0x68, 0x69, 0x66, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x1a, 0x12,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x50, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x50, 0x6f, 0x70, 0x49, 0x6e, 0x74,
-	0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x50, 0x6f, 0x70, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	List string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 }
 
-var (
-	file_proto_stricache_proto_rawDescOnce sync.Once
-	file_proto_stricache_proto_rawDescData = file_proto_stricache_proto_rawDesc
-)
+func (x *ListKey) Reset() {
+	*x = ListKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_stricache_proto_rawDescGZIP() []byte {
-	file_proto_stricache_proto_rawDescOnce.Do(func() {
-		file_proto_stricache_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_stricache_proto_rawDescData)
-	})
-	return file_proto_stricache_proto_rawDescData
+func (x *ListKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_stricache_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_proto_stricache_proto_goTypes = []interface{}{
-	(*StringItem)(nil), // 0: stricache.StringItem
-	(*IntItem)(nil),    // 1: stricache.IntItem
-	(*FloatItem)(nil),  // 2: stricache.FloatItem
-	(*GetKey)(nil),     // 3: stricache.GetKey
-	(*Success)(nil),    // 4: stricache.Success
-	(*EmptyR)(nil),     // 5: stricache.EmptyR
+func (*ListKey) ProtoMessage() {}
+
+func (x *ListKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_stricache_proto_depIdxs = []int32{
-	0,  // 0: stricache.StricacheService.AddString:input_type -> stricache.StringItem
-	1,  // 1: stricache.StricacheService.AddInt:input_type -> stricache.IntItem
-	2,  // 2: stricache.StricacheService.AddFloat:input_type -> stricache.FloatItem
-	0,  // 3: stricache.StricacheService.UnshiftString:input_type -> stricache.StringItem
-	1,  // 4: stricache.StricacheService.UnshiftInt:input_type -> stricache.IntItem
-	2,  // 5: stricache.StricacheService.UnshiftFloat:input_type -> stricache.FloatItem
-	3,  // 6: stricache.StricacheService.GetString:input_type -> stricache.GetKey
-	3,  // 7: stricache.StricacheService.GetInt:input_type -> stricache.GetKey
-	3,  // 8: stricache.StricacheService.GetFloat:input_type -> stricache.GetKey
-	3,  // 9: stricache.StricacheService.DeleteString:input_type -> stricache.GetKey
-	3,  // 10: stricache.StricacheService.DeleteInt:input_type -> stricache.GetKey
-	3,  // 11: stricache.StricacheService.DeleteFloat:input_type -> stricache.GetKey
-	5,  // 12: stricache.StricacheService.ShiftString:input_type -> stricache.EmptyR
-	5,  // 13: stricache.StricacheService.ShiftInt:input_type -> stricache.EmptyR
-	5,  // 14: stricache.StricacheService.ShiftFloat:input_type -> stricache.EmptyR
-	5,  // 15: stricache.StricacheService.PopString:input_type -> stricache.EmptyR
-	5,  // 16: stricache.StricacheService.PopInt:input_type -> stricache.EmptyR
-	5,  // 17: stricache.StricacheService.PopFloat:input_type -> stricache.EmptyR
-	0,  // 18: stricache.StricacheService.AddString:output_type -> stricache.StringItem
-	1,  // 19: stricache.StricacheService.AddInt:output_type -> stricache.IntItem
-	2,  // 20: stricache.StricacheService.AddFloat:output_type -> stricache.FloatItem
-	0,  // 21: stricache.StricacheService.UnshiftString:output_type -> stricache.StringItem
-	1,  // 22: stricache.StricacheService.UnshiftInt:output_type -> stricache.IntItem
-	2,  // 23: stricache.StricacheService.UnshiftFloat:output_type -> stricache.FloatItem
-	0,  // 24: stricache.StricacheService.GetString:output_type -> stricache.StringItem
-	1,  // 25: stricache.StricacheService.GetInt:output_type -> stricache.IntItem
-	2,  // 26: stricache.StricacheService.GetFloat:output_type -> stricache.FloatItem
-	4,  // 27: stricache.StricacheService.DeleteString:output_type -> stricache.Success
-	4,  // 28: stricache.StricacheService.DeleteInt:output_type -> stricache.Success
-	4,  // 29: stricache.StricacheService.DeleteFloat:output_type -> stricache.Success
-	4,  // 30: stricache.StricacheService.ShiftString:output_type -> stricache.Success
-	4,  // 31: stricache.StricacheService.ShiftInt:output_type -> stricache.Success
-	4,  // 32: stricache.StricacheService.ShiftFloat:output_type -> stricache.Success
-	4,  // 33: stricache.StricacheService.PopString:output_type -> stricache.Success
-	4,  // 34: stricache.StricacheService.PopInt:output_type -> stricache.Success
-	4,  // 35: stricache.StricacheService.PopFloat:output_type -> stricache.Success
-	18, // [18:36] is the sub-list for method output_type
-	0,  // [0:18] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use ListKey.ProtoReflect.Descriptor instead.
+func (*ListKey) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{7}
 }
 
-func init() { file_proto_stricache_proto_init() }
-func file_proto_stricache_proto_init() {
-	if File_proto_stricache_proto != nil {
-		return
+func (x *ListKey) GetList() string {
+	if x != nil {
+		return x.List
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_stricache_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringItem); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_stricache_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntItem); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_stricache_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatItem); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_stricache_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKey); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+// ListPop removes count elements from a list, one if count is 0.
+type ListPop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListPop) Reset() {
+	*x = ListPop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPop) ProtoMessage() {}
+
+func (x *ListPop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_stricache_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Success); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPop.ProtoReflect.Descriptor instead.
+func (*ListPop) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPop) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *ListPop) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// StringItems are elements removed from a list. Elements of the unnamed list
+// carry the key that held them, elements of named lists have no key.
+type StringItems struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string        `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Items []*StringItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *StringItems) Reset() {
+	*x = StringItems{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringItems) ProtoMessage() {}
+
+func (x *StringItems) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_stricache_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyR); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringItems.ProtoReflect.Descriptor instead.
+func (*StringItems) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StringItems) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *StringItems) GetItems() []*StringItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type IntItems struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string     `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Items []*IntItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *IntItems) Reset() {
+	*x = IntItems{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntItems) ProtoMessage() {}
+
+func (x *IntItems) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntItems.ProtoReflect.Descriptor instead.
+func (*IntItems) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IntItems) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *IntItems) GetItems() []*IntItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type FloatItems struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string       `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Items []*FloatItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *FloatItems) Reset() {
+	*x = FloatItems{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatItems) ProtoMessage() {}
+
+func (x *FloatItems) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatItems.ProtoReflect.Descriptor instead.
+func (*FloatItems) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FloatItems) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *FloatItems) GetItems() []*FloatItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BytesItems struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string       `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Items []*BytesItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BytesItems) Reset() {
+	*x = BytesItems{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesItems) ProtoMessage() {}
+
+func (x *BytesItems) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesItems.ProtoReflect.Descriptor instead.
+func (*BytesItems) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BytesItems) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *BytesItems) GetItems() []*BytesItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// BlockingPop waits for an element of a list. A timeout of 0 waits until the
+// call's deadline. If the timeout passes first no items are returned.
+type BlockingPop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List      string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	TimeoutMs int64  `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *BlockingPop) Reset() {
+	*x = BlockingPop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockingPop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockingPop) ProtoMessage() {}
+
+func (x *BlockingPop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockingPop.ProtoReflect.Descriptor instead.
+func (*BlockingPop) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BlockingPop) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *BlockingPop) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// ListRange selects elements start to stop, both inclusive. Negative indices
+// count from the end of the list, -1 being the last element.
+type ListRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop  int64  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *ListRange) Reset() {
+	*x = ListRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRange) ProtoMessage() {}
+
+func (x *ListRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRange.ProtoReflect.Descriptor instead.
+func (*ListRange) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRange) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *ListRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ListRange) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type ListIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Index int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ListIndex) Reset() {
+	*x = ListIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIndex) ProtoMessage() {}
+
+func (x *ListIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIndex.ProtoReflect.Descriptor instead.
+func (*ListIndex) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListIndex) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *ListIndex) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Count) Reset() {
+	*x = Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Count) ProtoMessage() {}
+
+func (x *Count) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Count.ProtoReflect.Descriptor instead.
+func (*Count) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Count) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StringList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type IntList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []int64 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *IntList) Reset() {
+	*x = IntList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntList) ProtoMessage() {}
+
+func (x *IntList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntList.ProtoReflect.Descriptor instead.
+func (*IntList) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IntList) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type FloatList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *FloatList) Reset() {
+	*x = FloatList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatList) ProtoMessage() {}
+
+func (x *FloatList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatList.ProtoReflect.Descriptor instead.
+func (*FloatList) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FloatList) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BytesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *BytesList) Reset() {
+	*x = BytesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesList) ProtoMessage() {}
+
+func (x *BytesList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesList.ProtoReflect.Descriptor instead.
+func (*BytesList) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BytesList) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type StringListSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Index int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StringListSet) Reset() {
+	*x = StringListSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringListSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringListSet) ProtoMessage() {}
+
+func (x *StringListSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringListSet.ProtoReflect.Descriptor instead.
+func (*StringListSet) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StringListSet) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *StringListSet) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StringListSet) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type IntListSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Index int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Value int64  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *IntListSet) Reset() {
+	*x = IntListSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntListSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntListSet) ProtoMessage() {}
+
+func (x *IntListSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntListSet.ProtoReflect.Descriptor instead.
+func (*IntListSet) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IntListSet) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *IntListSet) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *IntListSet) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type FloatListSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Index int64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FloatListSet) Reset() {
+	*x = FloatListSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatListSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatListSet) ProtoMessage() {}
+
+func (x *FloatListSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatListSet.ProtoReflect.Descriptor instead.
+func (*FloatListSet) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FloatListSet) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *FloatListSet) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FloatListSet) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type BytesListSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Index int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BytesListSet) Reset() {
+	*x = BytesListSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesListSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesListSet) ProtoMessage() {}
+
+func (x *BytesListSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesListSet.ProtoReflect.Descriptor instead.
+func (*BytesListSet) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BytesListSet) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *BytesListSet) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BytesListSet) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// StringListInsert puts value before the first element equal to pivot, or
+// after it if after is set.
+type StringListInsert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Pivot string `protobuf:"bytes,2,opt,name=pivot,proto3" json:"pivot,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	After bool   `protobuf:"varint,4,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *StringListInsert) Reset() {
+	*x = StringListInsert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringListInsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringListInsert) ProtoMessage() {}
+
+func (x *StringListInsert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringListInsert.ProtoReflect.Descriptor instead.
+func (*StringListInsert) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StringListInsert) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *StringListInsert) GetPivot() string {
+	if x != nil {
+		return x.Pivot
+	}
+	return ""
+}
+
+func (x *StringListInsert) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StringListInsert) GetAfter() bool {
+	if x != nil {
+		return x.After
+	}
+	return false
+}
+
+type IntListInsert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Pivot int64  `protobuf:"varint,2,opt,name=pivot,proto3" json:"pivot,omitempty"`
+	Value int64  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	After bool   `protobuf:"varint,4,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *IntListInsert) Reset() {
+	*x = IntListInsert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntListInsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntListInsert) ProtoMessage() {}
+
+func (x *IntListInsert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntListInsert.ProtoReflect.Descriptor instead.
+func (*IntListInsert) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *IntListInsert) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *IntListInsert) GetPivot() int64 {
+	if x != nil {
+		return x.Pivot
+	}
+	return 0
+}
+
+func (x *IntListInsert) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IntListInsert) GetAfter() bool {
+	if x != nil {
+		return x.After
+	}
+	return false
+}
+
+type FloatListInsert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Pivot float64 `protobuf:"fixed64,2,opt,name=pivot,proto3" json:"pivot,omitempty"`
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	After bool    `protobuf:"varint,4,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *FloatListInsert) Reset() {
+	*x = FloatListInsert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatListInsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatListInsert) ProtoMessage() {}
+
+func (x *FloatListInsert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatListInsert.ProtoReflect.Descriptor instead.
+func (*FloatListInsert) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FloatListInsert) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *FloatListInsert) GetPivot() float64 {
+	if x != nil {
+		return x.Pivot
+	}
+	return 0
+}
+
+func (x *FloatListInsert) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FloatListInsert) GetAfter() bool {
+	if x != nil {
+		return x.After
+	}
+	return false
+}
+
+type BytesListInsert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Pivot []byte `protobuf:"bytes,2,opt,name=pivot,proto3" json:"pivot,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	After bool   `protobuf:"varint,4,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *BytesListInsert) Reset() {
+	*x = BytesListInsert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesListInsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesListInsert) ProtoMessage() {}
+
+func (x *BytesListInsert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesListInsert.ProtoReflect.Descriptor instead.
+func (*BytesListInsert) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BytesListInsert) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *BytesListInsert) GetPivot() []byte {
+	if x != nil {
+		return x.Pivot
+	}
+	return nil
+}
+
+func (x *BytesListInsert) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *BytesListInsert) GetAfter() bool {
+	if x != nil {
+		return x.After
+	}
+	return false
+}
+
+// StringListRemove removes up to count elements equal to value, starting
+// from the front if count is positive and from the back if it is negative.
+// A count of 0 removes all of them.
+type StringListRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *StringListRemove) Reset() {
+	*x = StringListRemove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringListRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringListRemove) ProtoMessage() {}
+
+func (x *StringListRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringListRemove.ProtoReflect.Descriptor instead.
+func (*StringListRemove) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StringListRemove) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *StringListRemove) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StringListRemove) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type IntListRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *IntListRemove) Reset() {
+	*x = IntListRemove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntListRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntListRemove) ProtoMessage() {}
+
+func (x *IntListRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntListRemove.ProtoReflect.Descriptor instead.
+func (*IntListRemove) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *IntListRemove) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *IntListRemove) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IntListRemove) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FloatListRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FloatListRemove) Reset() {
+	*x = FloatListRemove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatListRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatListRemove) ProtoMessage() {}
+
+func (x *FloatListRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatListRemove.ProtoReflect.Descriptor instead.
+func (*FloatListRemove) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FloatListRemove) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *FloatListRemove) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FloatListRemove) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type BytesListRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BytesListRemove) Reset() {
+	*x = BytesListRemove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesListRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesListRemove) ProtoMessage() {}
+
+func (x *BytesListRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesListRemove.ProtoReflect.Descriptor instead.
+func (*BytesListRemove) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BytesListRemove) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *BytesListRemove) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *BytesListRemove) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StringListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StringListItem) Reset() {
+	*x = StringListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringListItem) ProtoMessage() {}
+
+func (x *StringListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringListItem.ProtoReflect.Descriptor instead.
+func (*StringListItem) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StringListItem) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *StringListItem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type IntListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *IntListItem) Reset() {
+	*x = IntListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntListItem) ProtoMessage() {}
+
+func (x *IntListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntListItem.ProtoReflect.Descriptor instead.
+func (*IntListItem) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *IntListItem) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *IntListItem) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type FloatListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FloatListItem) Reset() {
+	*x = FloatListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatListItem) ProtoMessage() {}
+
+func (x *FloatListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatListItem.ProtoReflect.Descriptor instead.
+func (*FloatListItem) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FloatListItem) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *FloatListItem) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type BytesListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BytesListItem) Reset() {
+	*x = BytesListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesListItem) ProtoMessage() {}
+
+func (x *BytesListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesListItem.ProtoReflect.Descriptor instead.
+func (*BytesListItem) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BytesListItem) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *BytesListItem) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// BytesRange selects bytes start to stop of a value, both inclusive.
+// Negative offsets count from the end of the value.
+type BytesRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop  int64  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *BytesRange) Reset() {
+	*x = BytesRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesRange) ProtoMessage() {}
+
+func (x *BytesRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesRange.ProtoReflect.Descriptor instead.
+func (*BytesRange) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BytesRange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BytesRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *BytesRange) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+// BytesSetRange overwrites a value from offset on, padding it with zero bytes
+// if it is shorter than offset.
+type BytesSetRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Value  []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BytesSetRange) Reset() {
+	*x = BytesSetRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesSetRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesSetRange) ProtoMessage() {}
+
+func (x *BytesSetRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesSetRange.ProtoReflect.Descriptor instead.
+func (*BytesSetRange) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BytesSetRange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BytesSetRange) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BytesSetRange) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ListInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List   string    `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Type   ValueType `protobuf:"varint,2,opt,name=type,proto3,enum=stricache.ValueType" json:"type,omitempty"`
+	Length int64     `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *ListInfo) Reset() {
+	*x = ListInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInfo) ProtoMessage() {}
+
+func (x *ListInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInfo.ProtoReflect.Descriptor instead.
+func (*ListInfo) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListInfo) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *ListInfo) GetType() ValueType {
+	if x != nil {
+		return x.Type
+	}
+	return ValueType_STRING
+}
+
+func (x *ListInfo) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type ListInfos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lists []*ListInfo `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+}
+
+func (x *ListInfos) Reset() {
+	*x = ListInfos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInfos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInfos) ProtoMessage() {}
+
+func (x *ListInfos) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInfos.ProtoReflect.Descriptor instead.
+func (*ListInfos) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListInfos) GetLists() []*ListInfo {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+// Limits are the limits requests are checked against, 0 or empty for none.
+type Limits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxKeyLength int64 `protobuf:"varint,1,opt,name=max_key_length,json=maxKeyLength,proto3" json:"max_key_length,omitempty"`
+	MaxValueSize int64 `protobuf:"varint,2,opt,name=max_value_size,json=maxValueSize,proto3" json:"max_value_size,omitempty"`
+	// key_charset is any, printable or ascii.
+	KeyCharset string `protobuf:"bytes,3,opt,name=key_charset,json=keyCharset,proto3" json:"key_charset,omitempty"`
+	// non_finite is what happens to NaN and infinite float values: allow,
+	// reject_nan or reject.
+	NonFinite     string `protobuf:"bytes,4,opt,name=non_finite,json=nonFinite,proto3" json:"non_finite,omitempty"`
+	MaxListLength int64  `protobuf:"varint,5,opt,name=max_list_length,json=maxListLength,proto3" json:"max_list_length,omitempty"`
+}
+
+func (x *Limits) Reset() {
+	*x = Limits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limits) ProtoMessage() {}
+
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Limits) GetMaxKeyLength() int64 {
+	if x != nil {
+		return x.MaxKeyLength
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxValueSize() int64 {
+	if x != nil {
+		return x.MaxValueSize
+	}
+	return 0
+}
+
+func (x *Limits) GetKeyCharset() string {
+	if x != nil {
+		return x.KeyCharset
+	}
+	return ""
+}
+
+func (x *Limits) GetNonFinite() string {
+	if x != nil {
+		return x.NonFinite
+	}
+	return ""
+}
+
+func (x *Limits) GetMaxListLength() int64 {
+	if x != nil {
+		return x.MaxListLength
+	}
+	return 0
+}
+
+type ServerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits *Limits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ServerInfo) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// TierInfo describes a storage tier of the tiered engine. Hits and misses
+// count reads of keys.
+type TierInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keys   int64  `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Bytes  int64  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Budget int64  `protobuf:"varint,4,opt,name=budget,proto3" json:"budget,omitempty"`
+	Hits   int64  `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses int64  `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+}
+
+func (x *TierInfo) Reset() {
+	*x = TierInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TierInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TierInfo) ProtoMessage() {}
+
+func (x *TierInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TierInfo.ProtoReflect.Descriptor instead.
+func (*TierInfo) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TierInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TierInfo) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *TierInfo) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *TierInfo) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *TierInfo) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *TierInfo) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+// TierInfos lists the tiers from memory to disk, none if the engine has no
+// tiers.
+type TierInfos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tiers []*TierInfo `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *TierInfos) Reset() {
+	*x = TierInfos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TierInfos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TierInfos) ProtoMessage() {}
+
+func (x *TierInfos) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TierInfos.ProtoReflect.Descriptor instead.
+func (*TierInfos) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TierInfos) GetTiers() []*TierInfo {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// TenantUsage is what a tenant stores and its quota, 0 meaning no limit.
+type TenantUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant        string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Keys          int64  `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Bytes         int64  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	MaxKeys       int64  `protobuf:"varint,4,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	MaxBytes      int64  `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxListLength int64  `protobuf:"varint,6,opt,name=max_list_length,json=maxListLength,proto3" json:"max_list_length,omitempty"`
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TenantUsage) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *TenantUsage) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetMaxKeys() int64 {
+	if x != nil {
+		return x.MaxKeys
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetMaxListLength() int64 {
+	if x != nil {
+		return x.MaxListLength
+	}
+	return 0
+}
+
+// CacheStats lists the usage of each tenant, none if quotas are disabled.
+type CacheStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenants []*TenantUsage `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CacheStats) GetTenants() []*TenantUsage {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+// HashFields are fields of the hash stored under key.
+type HashFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HashFields) Reset() {
+	*x = HashFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashFields) ProtoMessage() {}
+
+func (x *HashFields) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashFields.ProtoReflect.Descriptor instead.
+func (*HashFields) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *HashFields) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HashFields) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HashField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *HashField) Reset() {
+	*x = HashField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashField) ProtoMessage() {}
+
+func (x *HashField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashField.ProtoReflect.Descriptor instead.
+func (*HashField) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *HashField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HashField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type HashFieldNames struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *HashFieldNames) Reset() {
+	*x = HashFieldNames{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashFieldNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashFieldNames) ProtoMessage() {}
+
+func (x *HashFieldNames) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashFieldNames.ProtoReflect.Descriptor instead.
+func (*HashFieldNames) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *HashFieldNames) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HashFieldNames) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HashValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Found bool   `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *HashValue) Reset() {
+	*x = HashValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashValue) ProtoMessage() {}
+
+func (x *HashValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashValue.ProtoReflect.Descriptor instead.
+func (*HashValue) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *HashValue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HashValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HashValue) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type HashValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*HashValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *HashValues) Reset() {
+	*x = HashValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashValues) ProtoMessage() {}
+
+func (x *HashValues) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashValues.ProtoReflect.Descriptor instead.
+func (*HashValues) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *HashValues) GetValues() []*HashValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type HashIncr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Delta int64  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *HashIncr) Reset() {
+	*x = HashIncr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashIncr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashIncr) ProtoMessage() {}
+
+func (x *HashIncr) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashIncr.ProtoReflect.Descriptor instead.
+func (*HashIncr) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *HashIncr) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HashIncr) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HashIncr) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+// HashScan pages through the fields of a hash in field order. Pass the
+// cursor of the previous page to continue, and optionally a glob pattern
+// fields must match.
+type HashScan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Match  string `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	Count  int64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *HashScan) Reset() {
+	*x = HashScan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashScan) ProtoMessage() {}
+
+func (x *HashScan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashScan.ProtoReflect.Descriptor instead.
+func (*HashScan) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *HashScan) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HashScan) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *HashScan) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *HashScan) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// HashScanPage is one page of fields. An empty cursor means the scan is done.
+type HashScanPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor string       `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Values []*HashValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *HashScanPage) Reset() {
+	*x = HashScanPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashScanPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashScanPage) ProtoMessage() {}
+
+func (x *HashScanPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashScanPage.ProtoReflect.Descriptor instead.
+func (*HashScanPage) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HashScanPage) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *HashScanPage) GetValues() []*HashValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// SetMembers are members of the set stored under key.
+type SetMembers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SetMembers) Reset() {
+	*x = SetMembers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMembers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMembers) ProtoMessage() {}
+
+func (x *SetMembers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMembers.ProtoReflect.Descriptor instead.
+func (*SetMembers) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetMembers) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetMembers) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SetMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *SetMember) Reset() {
+	*x = SetMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMember) ProtoMessage() {}
+
+func (x *SetMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMember.ProtoReflect.Descriptor instead.
+func (*SetMember) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetMember) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetMember) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type IsMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member bool `protobuf:"varint,1,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *IsMember) Reset() {
+	*x = IsMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMember) ProtoMessage() {}
+
+func (x *IsMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMember.ProtoReflect.Descriptor instead.
+func (*IsMember) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *IsMember) GetMember() bool {
+	if x != nil {
+		return x.Member
+	}
+	return false
+}
+
+// SetRandom picks count members of a set, 1 if count is 0. SRandMember
+// returns distinct members for a positive count and may repeat members for
+// a negative one.
+type SetRandom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SetRandom) Reset() {
+	*x = SetRandom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRandom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRandom) ProtoMessage() {}
+
+func (x *SetRandom) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRandom.ProtoReflect.Descriptor instead.
+func (*SetRandom) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetRandom) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetRandom) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SetKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SetKeys) Reset() {
+	*x = SetKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKeys) ProtoMessage() {}
+
+func (x *SetKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKeys.ProtoReflect.Descriptor instead.
+func (*SetKeys) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetKeys) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SetStore stores the result of a set operation on keys under destination.
+type SetStore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string   `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys        []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SetStore) Reset() {
+	*x = SetStore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStore) ProtoMessage() {}
+
+func (x *SetStore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStore.ProtoReflect.Descriptor instead.
+func (*SetStore) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetStore) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SetStore) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SortedSetItems are members of the sorted set stored under key, each
+// FloatItem holding a member as key and its score as value.
+type SortedSetItems struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []*FloatItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SortedSetItems) Reset() {
+	*x = SortedSetItems{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortedSetItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortedSetItems) ProtoMessage() {}
+
+func (x *SortedSetItems) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortedSetItems.ProtoReflect.Descriptor instead.
+func (*SortedSetItems) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SortedSetItems) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SortedSetItems) GetItems() []*FloatItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SortedSetIncr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member string  `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Delta  float64 `protobuf:"fixed64,3,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *SortedSetIncr) Reset() {
+	*x = SortedSetIncr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortedSetIncr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortedSetIncr) ProtoMessage() {}
+
+func (x *SortedSetIncr) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortedSetIncr.ProtoReflect.Descriptor instead.
+func (*SortedSetIncr) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SortedSetIncr) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SortedSetIncr) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *SortedSetIncr) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type SortedSetRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member  string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Reverse bool   `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
+}
+
+func (x *SortedSetRank) Reset() {
+	*x = SortedSetRank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortedSetRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortedSetRank) ProtoMessage() {}
+
+func (x *SortedSetRank) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortedSetRank.ProtoReflect.Descriptor instead.
+func (*SortedSetRank) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SortedSetRank) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SortedSetRank) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *SortedSetRank) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+// RankRange selects members by inclusive ranks, negative ranks counting
+// from the end. Reverse ranks count from the highest score.
+type RankRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start   int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop    int64  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	Reverse bool   `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+}
+
+func (x *RankRange) Reset() {
+	*x = RankRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankRange) ProtoMessage() {}
+
+func (x *RankRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankRange.ProtoReflect.Descriptor instead.
+func (*RankRange) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RankRange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RankRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *RankRange) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+func (x *RankRange) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+// ScoreRange selects members with scores between min and max, which may be
+// infinite. Offset and count page through the result, a count of 0 meaning
+// all of it. Reverse returns the highest scores first.
+type ScoreRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min          float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max          float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	MinExclusive bool    `protobuf:"varint,4,opt,name=min_exclusive,json=minExclusive,proto3" json:"min_exclusive,omitempty"`
+	MaxExclusive bool    `protobuf:"varint,5,opt,name=max_exclusive,json=maxExclusive,proto3" json:"max_exclusive,omitempty"`
+	Reverse      bool    `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset       int64   `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count        int64   `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ScoreRange) Reset() {
+	*x = ScoreRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreRange) ProtoMessage() {}
+
+func (x *ScoreRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreRange.ProtoReflect.Descriptor instead.
+func (*ScoreRange) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ScoreRange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ScoreRange) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ScoreRange) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ScoreRange) GetMinExclusive() bool {
+	if x != nil {
+		return x.MinExclusive
+	}
+	return false
+}
+
+func (x *ScoreRange) GetMaxExclusive() bool {
+	if x != nil {
+		return x.MaxExclusive
+	}
+	return false
+}
+
+func (x *ScoreRange) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *ScoreRange) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ScoreRange) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// LexRange selects members between min and max in byte order, for sorted
+// sets whose members all have the same score. An empty max has no upper
+// bound.
+type LexRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min          string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max          string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	MinExclusive bool   `protobuf:"varint,4,opt,name=min_exclusive,json=minExclusive,proto3" json:"min_exclusive,omitempty"`
+	MaxExclusive bool   `protobuf:"varint,5,opt,name=max_exclusive,json=maxExclusive,proto3" json:"max_exclusive,omitempty"`
+	Reverse      bool   `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Offset       int64  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count        int64  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *LexRange) Reset() {
+	*x = LexRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexRange) ProtoMessage() {}
+
+func (x *LexRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexRange.ProtoReflect.Descriptor instead.
+func (*LexRange) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LexRange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LexRange) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *LexRange) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *LexRange) GetMinExclusive() bool {
+	if x != nil {
+		return x.MinExclusive
+	}
+	return false
+}
+
+func (x *LexRange) GetMaxExclusive() bool {
+	if x != nil {
+		return x.MaxExclusive
+	}
+	return false
+}
+
+func (x *LexRange) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *LexRange) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LexRange) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SortedSetPop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SortedSetPop) Reset() {
+	*x = SortedSetPop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortedSetPop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortedSetPop) ProtoMessage() {}
+
+func (x *SortedSetPop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortedSetPop.ProtoReflect.Descriptor instead.
+func (*SortedSetPop) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SortedSetPop) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SortedSetPop) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Document is a value of any message type, packed with its type URL. JSON
+// documents are stored as google.protobuf.Struct.
+type Document struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *anypb.Any `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Document) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Document) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// DocumentUpdate stores a document. With an update mask only the listed fields
+// are copied to the stored document, which must have the same type; fields
+// in the mask that value doesn't set are cleared. Masks need a type the
+// server knows: a well-known type or one added with RegisterTypes. Paths
+// into a Struct name its keys.
+type DocumentUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      *anypb.Any             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *DocumentUpdate) Reset() {
+	*x = DocumentUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentUpdate) ProtoMessage() {}
+
+func (x *DocumentUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentUpdate.ProtoReflect.Descriptor instead.
+func (*DocumentUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DocumentUpdate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DocumentUpdate) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *DocumentUpdate) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DocumentFilter selects documents by type URL and key prefix. Empty fields
+// match everything.
+type DocumentFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Prefix  string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *DocumentFilter) Reset() {
+	*x = DocumentFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentFilter) ProtoMessage() {}
+
+func (x *DocumentFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentFilter.ProtoReflect.Descriptor instead.
+func (*DocumentFilter) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DocumentFilter) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
+	}
+	return ""
+}
+
+func (x *DocumentFilter) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type Documents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Documents []*Document `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *Documents) Reset() {
+	*x = Documents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Documents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Documents) ProtoMessage() {}
+
+func (x *Documents) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Documents.ProtoReflect.Descriptor instead.
+func (*Documents) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Documents) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+// JSON calls address parts of a JSON document with paths like $.a.b[0],
+// $['a'][*] or a.*. The empty path and $ are the whole document. Values are
+// JSON text.
+type JSONValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path  string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *JSONValue) Reset() {
+	*x = JSONValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONValue) ProtoMessage() {}
+
+func (x *JSONValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONValue.ProtoReflect.Descriptor instead.
+func (*JSONValue) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *JSONValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONValue) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type JSONPaths struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *JSONPaths) Reset() {
+	*x = JSONPaths{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONPaths) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONPaths) ProtoMessage() {}
+
+func (x *JSONPaths) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONPaths.ProtoReflect.Descriptor instead.
+func (*JSONPaths) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *JSONPaths) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONPaths) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// JSONMatch holds the values a path matched, in document order.
+type JSONMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *JSONMatch) Reset() {
+	*x = JSONMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONMatch) ProtoMessage() {}
+
+func (x *JSONMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONMatch.ProtoReflect.Descriptor instead.
+func (*JSONMatch) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *JSONMatch) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONMatch) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type JSONMatches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*JSONMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *JSONMatches) Reset() {
+	*x = JSONMatches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONMatches) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONMatches) ProtoMessage() {}
+
+func (x *JSONMatches) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONMatches.ProtoReflect.Descriptor instead.
+func (*JSONMatches) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *JSONMatches) GetMatches() []*JSONMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type JSONPath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *JSONPath) Reset() {
+	*x = JSONPath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONPath) ProtoMessage() {}
+
+func (x *JSONPath) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONPath.ProtoReflect.Descriptor instead.
+func (*JSONPath) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *JSONPath) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONPath) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type JSONValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path   string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *JSONValues) Reset() {
+	*x = JSONValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONValues) ProtoMessage() {}
+
+func (x *JSONValues) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONValues.ProtoReflect.Descriptor instead.
+func (*JSONValues) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *JSONValues) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONValues) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONValues) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type JSONNumIncr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path  string  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Delta float64 `protobuf:"fixed64,3,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *JSONNumIncr) Reset() {
+	*x = JSONNumIncr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONNumIncr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONNumIncr) ProtoMessage() {}
+
+func (x *JSONNumIncr) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONNumIncr.ProtoReflect.Descriptor instead.
+func (*JSONNumIncr) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *JSONNumIncr) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONNumIncr) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONNumIncr) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type Counts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts []int64 `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *Counts) Reset() {
+	*x = Counts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stricache_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Counts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counts) ProtoMessage() {}
+
+func (x *Counts) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stricache_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counts.ProtoReflect.Descriptor instead.
+func (*Counts) Descriptor() ([]byte, []int) {
+	return file_proto_stricache_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Counts) GetCounts() []int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+var File_proto_stricache_proto protoreflect.FileDescriptor
+
+var file_proto_stricache_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a, 0x07, 0x49, 0x6e, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x33, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x1a, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x3d, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
+	0x08, 0x0a, 0x06, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x22, 0x1d, 0x0a, 0x07, 0x4c, 0x69, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a,
+	0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x48, 0x0a,
+	0x08, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4c, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4c, 0x0a, 0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x40, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x49, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70,
+	0x22, 0x35, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x1d, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x24, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x21, 0x0a, 0x07,
+	0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x23, 0x0a, 0x09, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4c, 0x0a, 0x0a, 0x49, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4e, 0x0a, 0x0c, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4e, 0x0a, 0x0c, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x22, 0x65, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x67, 0x0a, 0x0f, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x22, 0x67, 0x0a, 0x0f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x76,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x10, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x4f, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x51, 0x0a, 0x0f, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x0f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x48, 0x0a, 0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x4f, 0x0a, 0x0d, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x60, 0x0a, 0x08,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x36,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4b, 0x65,
+	0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x43, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x37, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x8c,
+	0x01, 0x0a, 0x08, 0x54, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x22, 0x36, 0x0a,
+	0x09, 0x54, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x54, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3e, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x48, 0x61, 0x73, 0x68,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x33,
+	0x0a, 0x09, 0x48, 0x61, 0x73, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x22, 0x3a, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
+	0x4d, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x3a,
+	0x0a, 0x0a, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x08, 0x48, 0x61,
+	0x73, 0x68, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68, 0x53, 0x63, 0x61, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x0c, 0x48, 0x61, 0x73, 0x68, 0x53, 0x63,
+	0x61, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2c,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x0a,
+	0x53, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x22, 0x0a,
+	0x08, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x33, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1d, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x40, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x53, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x61, 0x0a,
+	0x09, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x22, 0xd4, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x08, 0x4c, 0x65, 0x78, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69,
+	0x6e, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x0c,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8b,
+	0x01, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x43, 0x0a, 0x0e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31,
+	0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x47, 0x0a, 0x09, 0x4a, 0x53, 0x4f, 0x4e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x4a, 0x53,
+	0x4f, 0x4e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22,
+	0x37, 0x0a, 0x09, 0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x4a, 0x53, 0x4f, 0x4e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x08, 0x4a, 0x53, 0x4f, 0x4e, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4a, 0x0a, 0x0a, 0x4a, 0x53, 0x4f,
+	0x4e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x75, 0x6d,
+	0x49, 0x6e, 0x63, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x22, 0x20, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2a, 0x36, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x02, 0x12,
+	0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x03, 0x32, 0xdc, 0x36, 0x0a, 0x10, 0x53,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x39, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x06, 0x41, 0x64,
+	0x64, 0x49, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x36, 0x0a, 0x08,
+	0x41, 0x64, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x0d, 0x55, 0x6e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x15, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x34, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x49, 0x6e,
+	0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3a, 0x0a, 0x0c, 0x55, 0x6e, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a,
+	0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x35, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x06,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x33, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x14, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x35, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x11, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a,
+	0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x33,
+	0x0a, 0x08, 0x53, 0x68, 0x69, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x13,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x68, 0x69, 0x66, 0x74, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x09,
+	0x50, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x16, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x50, 0x6f, 0x70, 0x49, 0x6e, 0x74, 0x12,
+	0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x70, 0x1a, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x50, 0x6f, 0x70, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x3b, 0x0a, 0x0a, 0x50, 0x75, 0x73, 0x68, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x07,
+	0x50, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a,
+	0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x12, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a,
+	0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x30,
+	0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x12, 0x30, 0x0a, 0x05, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x1a, 0x14, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x54, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x1a, 0x15, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x11, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3f,
+	0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x69, 0x66, 0x74, 0x49,
+	0x6e, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x1a, 0x13, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x43, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x70, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x49, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x70, 0x1a, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x3d, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x41,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x1a,
+	0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x6d, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x10,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x12, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x6e,
+	0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x12, 0x15, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69,
+	0x6d, 0x49, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x12,
+	0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x10, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12,
+	0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12,
+	0x17, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12,
+	0x1a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x6d, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x6e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x10, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2f, 0x0a, 0x04, 0x48, 0x53, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x10,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x04, 0x48, 0x47, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x48, 0x4d, 0x47, 0x65, 0x74, 0x12, 0x19, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x33, 0x0a, 0x04, 0x48, 0x44, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x48, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12,
+	0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b,
+	0x65, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x48,
+	0x61, 0x73, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x48, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04,
+	0x48, 0x4c, 0x65, 0x6e, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x48, 0x49, 0x6e,
+	0x63, 0x72, 0x42, 0x79, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x63, 0x72, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x35, 0x0a,
+	0x05, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x53, 0x63, 0x61, 0x6e, 0x1a, 0x17, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x53, 0x63, 0x61, 0x6e,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x53, 0x41, 0x64,
+	0x64, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x53, 0x52,
+	0x65, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x53,
+	0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x13,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x73, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x53, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b,
+	0x65, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x53, 0x43, 0x61,
+	0x72, 0x64, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x52, 0x61, 0x6e, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x1a, 0x15, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x53, 0x50, 0x6f, 0x70, 0x12, 0x14, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x53, 0x55, 0x6e, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a,
+	0x06, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x15, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x53, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x1a,
+	0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x53, 0x55, 0x6e, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b,
+	0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x5a,
+	0x41, 0x64, 0x64, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x10,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x07, 0x5a, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x12, 0x18, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x63, 0x72, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x34, 0x0a, 0x06, 0x5a,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x14, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x33, 0x0a, 0x05, 0x5a, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x6b, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3d, 0x0a,
+	0x0d, 0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x15,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0b,
+	0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x79, 0x4c, 0x65, 0x78, 0x12, 0x13, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x65, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x5a, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x5a, 0x43,
+	0x61, 0x72, 0x64, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x5a, 0x52, 0x65, 0x6d,
+	0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x5a, 0x50, 0x6f,
+	0x70, 0x4d, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x61, 0x78, 0x12,
+	0x17, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x38, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x41, 0x64, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x14, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x3a, 0x0a, 0x0c, 0x55, 0x6e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x33, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x14, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x34, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x35, 0x0a, 0x08, 0x50, 0x6f, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x70, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x1a,
+	0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43,
+	0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x69, 0x66, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x1a, 0x15, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x70, 0x1a,
+	0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x18, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x1a,
+	0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x6d,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x10,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x34, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x1a, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x1a, 0x13, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x4b, 0x65, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x1a,
+	0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x07, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x4a, 0x53, 0x4f, 0x4e, 0x47, 0x65, 0x74, 0x12,
+	0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x53, 0x4f, 0x4e,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x07, 0x4a, 0x53, 0x4f, 0x4e, 0x44, 0x65, 0x6c, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x10, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x39, 0x0a, 0x0d, 0x4a, 0x53, 0x4f, 0x4e, 0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x12, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x53, 0x4f,
+	0x4e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x4a, 0x53,
+	0x4f, 0x4e, 0x4e, 0x75, 0x6d, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x12, 0x16, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x75, 0x6d, 0x49,
+	0x6e, 0x63, 0x72, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x61, 0x63, 0x68, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_stricache_proto_rawDescOnce sync.Once
+	file_proto_stricache_proto_rawDescData = file_proto_stricache_proto_rawDesc
+)
+
+func file_proto_stricache_proto_rawDescGZIP() []byte {
+	file_proto_stricache_proto_rawDescOnce.Do(func() {
+		file_proto_stricache_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_stricache_proto_rawDescData)
+	})
+	return file_proto_stricache_proto_rawDescData
+}
+
+var file_proto_stricache_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_stricache_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_proto_stricache_proto_goTypes = []interface{}{
+	(ValueType)(0),                         // 0: stricache.ValueType
+	(*StringItem)(nil),                     // 1: stricache.StringItem
+	(*IntItem)(nil),                        // 2: stricache.IntItem
+	(*FloatItem)(nil),                      // 3: stricache.FloatItem
+	(*BytesItem)(nil),                      // 4: stricache.BytesItem
+	(*GetKey)(nil),                         // 5: stricache.GetKey
+	(*Success)(nil),                        // 6: stricache.Success
+	(*EmptyR)(nil),                         // 7: stricache.EmptyR
+	(*ListKey)(nil),                        // 8: stricache.ListKey
+	(*ListPop)(nil),                        // 9: stricache.ListPop
+	(*StringItems)(nil),                    // 10: stricache.StringItems
+	(*IntItems)(nil),                       // 11: stricache.IntItems
+	(*FloatItems)(nil),                     // 12: stricache.FloatItems
+	(*BytesItems)(nil),                     // 13: stricache.BytesItems
+	(*BlockingPop)(nil),                    // 14: stricache.BlockingPop
+	(*ListRange)(nil),                      // 15: stricache.ListRange
+	(*ListIndex)(nil),                      // 16: stricache.ListIndex
+	(*Count)(nil),                          // 17: stricache.Count
+	(*StringList)(nil),                     // 18: stricache.StringList
+	(*IntList)(nil),                        // 19: stricache.IntList
+	(*FloatList)(nil),                      // 20: stricache.FloatList
+	(*BytesList)(nil),                      // 21: stricache.BytesList
+	(*StringListSet)(nil),                  // 22: stricache.StringListSet
+	(*IntListSet)(nil),                     // 23: stricache.IntListSet
+	(*FloatListSet)(nil),                   // 24: stricache.FloatListSet
+	(*BytesListSet)(nil),                   // 25: stricache.BytesListSet
+	(*StringListInsert)(nil),               // 26: stricache.StringListInsert
+	(*IntListInsert)(nil),                  // 27: stricache.IntListInsert
+	(*FloatListInsert)(nil),                // 28: stricache.FloatListInsert
+	(*BytesListInsert)(nil),                // 29: stricache.BytesListInsert
+	(*StringListRemove)(nil),               // 30: stricache.StringListRemove
+	(*IntListRemove)(nil),                  // 31: stricache.IntListRemove
+	(*FloatListRemove)(nil),                // 32: stricache.FloatListRemove
+	(*BytesListRemove)(nil),                // 33: stricache.BytesListRemove
+	(*StringListItem)(nil),                 // 34: stricache.StringListItem
+	(*IntListItem)(nil),                    // 35: stricache.IntListItem
+	(*FloatListItem)(nil),                  // 36: stricache.FloatListItem
+	(*BytesListItem)(nil),                  // 37: stricache.BytesListItem
+	(*BytesRange)(nil),                     // 38: stricache.BytesRange
+	(*BytesSetRange)(nil),                  // 39: stricache.BytesSetRange
+	(*ListInfo)(nil),                       // 40: stricache.ListInfo
+	(*ListInfos)(nil),                      // 41: stricache.ListInfos
+	(*Limits)(nil),                         // 42: stricache.Limits
+	(*ServerInfo)(nil),                     // 43: stricache.ServerInfo
+	(*TierInfo)(nil),                       // 44: stricache.TierInfo
+	(*TierInfos)(nil),                      // 45: stricache.TierInfos
+	(*TenantUsage)(nil),                    // 46: stricache.TenantUsage
+	(*CacheStats)(nil),                     // 47: stricache.CacheStats
+	(*HashFields)(nil),                     // 48: stricache.HashFields
+	(*HashField)(nil),                      // 49: stricache.HashField
+	(*HashFieldNames)(nil),                 // 50: stricache.HashFieldNames
+	(*HashValue)(nil),                      // 51: stricache.HashValue
+	(*HashValues)(nil),                     // 52: stricache.HashValues
+	(*HashIncr)(nil),                       // 53: stricache.HashIncr
+	(*HashScan)(nil),                       // 54: stricache.HashScan
+	(*HashScanPage)(nil),                   // 55: stricache.HashScanPage
+	(*SetMembers)(nil),                     // 56: stricache.SetMembers
+	(*SetMember)(nil),                      // 57: stricache.SetMember
+	(*IsMember)(nil),                       // 58: stricache.IsMember
+	(*SetRandom)(nil),                      // 59: stricache.SetRandom
+	(*SetKeys)(nil),                        // 60: stricache.SetKeys
+	(*SetStore)(nil),                       // 61: stricache.SetStore
+	(*SortedSetItems)(nil),                 // 62: stricache.SortedSetItems
+	(*SortedSetIncr)(nil),                  // 63: stricache.SortedSetIncr
+	(*SortedSetRank)(nil),                  // 64: stricache.SortedSetRank
+	(*RankRange)(nil),                      // 65: stricache.RankRange
+	(*ScoreRange)(nil),                     // 66: stricache.ScoreRange
+	(*LexRange)(nil),                       // 67: stricache.LexRange
+	(*SortedSetPop)(nil),                   // 68: stricache.SortedSetPop
+	(*Document)(nil),                       // 69: stricache.Document
+	(*DocumentUpdate)(nil),                 // 70: stricache.DocumentUpdate
+	(*DocumentFilter)(nil),                 // 71: stricache.DocumentFilter
+	(*Documents)(nil),                      // 72: stricache.Documents
+	(*JSONValue)(nil),                      // 73: stricache.JSONValue
+	(*JSONPaths)(nil),                      // 74: stricache.JSONPaths
+	(*JSONMatch)(nil),                      // 75: stricache.JSONMatch
+	(*JSONMatches)(nil),                    // 76: stricache.JSONMatches
+	(*JSONPath)(nil),                       // 77: stricache.JSONPath
+	(*JSONValues)(nil),                     // 78: stricache.JSONValues
+	(*JSONNumIncr)(nil),                    // 79: stricache.JSONNumIncr
+	(*Counts)(nil),                         // 80: stricache.Counts
+	nil,                                    // 81: stricache.HashFields.FieldsEntry
+	(*anypb.Any)(nil),                      // 82: google.protobuf.Any
+	(*fieldmaskpb.FieldMask)(nil),          // 83: google.protobuf.FieldMask
+	(*descriptorpb.FileDescriptorSet)(nil), // 84: google.protobuf.FileDescriptorSet
+}
+var file_proto_stricache_proto_depIdxs = []int32{
+	1,   // 0: stricache.StringItems.items:type_name -> stricache.StringItem
+	2,   // 1: stricache.IntItems.items:type_name -> stricache.IntItem
+	3,   // 2: stricache.FloatItems.items:type_name -> stricache.FloatItem
+	4,   // 3: stricache.BytesItems.items:type_name -> stricache.BytesItem
+	0,   // 4: stricache.ListInfo.type:type_name -> stricache.ValueType
+	40,  // 5: stricache.ListInfos.lists:type_name -> stricache.ListInfo
+	42,  // 6: stricache.ServerInfo.limits:type_name -> stricache.Limits
+	44,  // 7: stricache.TierInfos.tiers:type_name -> stricache.TierInfo
+	46,  // 8: stricache.CacheStats.tenants:type_name -> stricache.TenantUsage
+	81,  // 9: stricache.HashFields.fields:type_name -> stricache.HashFields.FieldsEntry
+	51,  // 10: stricache.HashValues.values:type_name -> stricache.HashValue
+	51,  // 11: stricache.HashScanPage.values:type_name -> stricache.HashValue
+	3,   // 12: stricache.SortedSetItems.items:type_name -> stricache.FloatItem
+	82,  // 13: stricache.Document.value:type_name -> google.protobuf.Any
+	82,  // 14: stricache.DocumentUpdate.value:type_name -> google.protobuf.Any
+	83,  // 15: stricache.DocumentUpdate.update_mask:type_name -> google.protobuf.FieldMask
+	69,  // 16: stricache.Documents.documents:type_name -> stricache.Document
+	75,  // 17: stricache.JSONMatches.matches:type_name -> stricache.JSONMatch
+	1,   // 18: stricache.StricacheService.AddString:input_type -> stricache.StringItem
+	2,   // 19: stricache.StricacheService.AddInt:input_type -> stricache.IntItem
+	3,   // 20: stricache.StricacheService.AddFloat:input_type -> stricache.FloatItem
+	1,   // 21: stricache.StricacheService.UnshiftString:input_type -> stricache.StringItem
+	2,   // 22: stricache.StricacheService.UnshiftInt:input_type -> stricache.IntItem
+	3,   // 23: stricache.StricacheService.UnshiftFloat:input_type -> stricache.FloatItem
+	5,   // 24: stricache.StricacheService.GetString:input_type -> stricache.GetKey
+	5,   // 25: stricache.StricacheService.GetInt:input_type -> stricache.GetKey
+	5,   // 26: stricache.StricacheService.GetFloat:input_type -> stricache.GetKey
+	5,   // 27: stricache.StricacheService.DeleteString:input_type -> stricache.GetKey
+	5,   // 28: stricache.StricacheService.DeleteInt:input_type -> stricache.GetKey
+	5,   // 29: stricache.StricacheService.DeleteFloat:input_type -> stricache.GetKey
+	9,   // 30: stricache.StricacheService.ShiftString:input_type -> stricache.ListPop
+	9,   // 31: stricache.StricacheService.ShiftInt:input_type -> stricache.ListPop
+	9,   // 32: stricache.StricacheService.ShiftFloat:input_type -> stricache.ListPop
+	9,   // 33: stricache.StricacheService.PopString:input_type -> stricache.ListPop
+	9,   // 34: stricache.StricacheService.PopInt:input_type -> stricache.ListPop
+	9,   // 35: stricache.StricacheService.PopFloat:input_type -> stricache.ListPop
+	34,  // 36: stricache.StricacheService.PushString:input_type -> stricache.StringListItem
+	35,  // 37: stricache.StricacheService.PushInt:input_type -> stricache.IntListItem
+	36,  // 38: stricache.StricacheService.PushFloat:input_type -> stricache.FloatListItem
+	8,   // 39: stricache.StricacheService.DeleteStringList:input_type -> stricache.ListKey
+	8,   // 40: stricache.StricacheService.DeleteIntList:input_type -> stricache.ListKey
+	8,   // 41: stricache.StricacheService.DeleteFloatList:input_type -> stricache.ListKey
+	7,   // 42: stricache.StricacheService.Lists:input_type -> stricache.EmptyR
+	7,   // 43: stricache.StricacheService.Tiers:input_type -> stricache.EmptyR
+	7,   // 44: stricache.StricacheService.Info:input_type -> stricache.EmptyR
+	7,   // 45: stricache.StricacheService.Stats:input_type -> stricache.EmptyR
+	14,  // 46: stricache.StricacheService.BlockingShiftString:input_type -> stricache.BlockingPop
+	14,  // 47: stricache.StricacheService.BlockingShiftInt:input_type -> stricache.BlockingPop
+	14,  // 48: stricache.StricacheService.BlockingShiftFloat:input_type -> stricache.BlockingPop
+	14,  // 49: stricache.StricacheService.BlockingPopString:input_type -> stricache.BlockingPop
+	14,  // 50: stricache.StricacheService.BlockingPopInt:input_type -> stricache.BlockingPop
+	14,  // 51: stricache.StricacheService.BlockingPopFloat:input_type -> stricache.BlockingPop
+	15,  // 52: stricache.StricacheService.ListRangeString:input_type -> stricache.ListRange
+	16,  // 53: stricache.StricacheService.ListIndexString:input_type -> stricache.ListIndex
+	22,  // 54: stricache.StricacheService.ListSetString:input_type -> stricache.StringListSet
+	26,  // 55: stricache.StricacheService.ListInsertString:input_type -> stricache.StringListInsert
+	15,  // 56: stricache.StricacheService.ListTrimString:input_type -> stricache.ListRange
+	30,  // 57: stricache.StricacheService.ListRemoveString:input_type -> stricache.StringListRemove
+	8,   // 58: stricache.StricacheService.ListLenString:input_type -> stricache.ListKey
+	15,  // 59: stricache.StricacheService.ListRangeInt:input_type -> stricache.ListRange
+	16,  // 60: stricache.StricacheService.ListIndexInt:input_type -> stricache.ListIndex
+	23,  // 61: stricache.StricacheService.ListSetInt:input_type -> stricache.IntListSet
+	27,  // 62: stricache.StricacheService.ListInsertInt:input_type -> stricache.IntListInsert
+	15,  // 63: stricache.StricacheService.ListTrimInt:input_type -> stricache.ListRange
+	31,  // 64: stricache.StricacheService.ListRemoveInt:input_type -> stricache.IntListRemove
+	8,   // 65: stricache.StricacheService.ListLenInt:input_type -> stricache.ListKey
+	15,  // 66: stricache.StricacheService.ListRangeFloat:input_type -> stricache.ListRange
+	16,  // 67: stricache.StricacheService.ListIndexFloat:input_type -> stricache.ListIndex
+	24,  // 68: stricache.StricacheService.ListSetFloat:input_type -> stricache.FloatListSet
+	28,  // 69: stricache.StricacheService.ListInsertFloat:input_type -> stricache.FloatListInsert
+	15,  // 70: stricache.StricacheService.ListTrimFloat:input_type -> stricache.ListRange
+	32,  // 71: stricache.StricacheService.ListRemoveFloat:input_type -> stricache.FloatListRemove
+	8,   // 72: stricache.StricacheService.ListLenFloat:input_type -> stricache.ListKey
+	48,  // 73: stricache.StricacheService.HSet:input_type -> stricache.HashFields
+	49,  // 74: stricache.StricacheService.HGet:input_type -> stricache.HashField
+	50,  // 75: stricache.StricacheService.HMGet:input_type -> stricache.HashFieldNames
+	50,  // 76: stricache.StricacheService.HDel:input_type -> stricache.HashFieldNames
+	5,   // 77: stricache.StricacheService.HGetAll:input_type -> stricache.GetKey
+	5,   // 78: stricache.StricacheService.HKeys:input_type -> stricache.GetKey
+	5,   // 79: stricache.StricacheService.HLen:input_type -> stricache.GetKey
+	53,  // 80: stricache.StricacheService.HIncrBy:input_type -> stricache.HashIncr
+	54,  // 81: stricache.StricacheService.HScan:input_type -> stricache.HashScan
+	5,   // 82: stricache.StricacheService.DeleteHash:input_type -> stricache.GetKey
+	56,  // 83: stricache.StricacheService.SAdd:input_type -> stricache.SetMembers
+	56,  // 84: stricache.StricacheService.SRem:input_type -> stricache.SetMembers
+	57,  // 85: stricache.StricacheService.SIsMember:input_type -> stricache.SetMember
+	5,   // 86: stricache.StricacheService.SMembers:input_type -> stricache.GetKey
+	5,   // 87: stricache.StricacheService.SCard:input_type -> stricache.GetKey
+	59,  // 88: stricache.StricacheService.SRandMember:input_type -> stricache.SetRandom
+	59,  // 89: stricache.StricacheService.SPop:input_type -> stricache.SetRandom
+	60,  // 90: stricache.StricacheService.SUnion:input_type -> stricache.SetKeys
+	60,  // 91: stricache.StricacheService.SInter:input_type -> stricache.SetKeys
+	60,  // 92: stricache.StricacheService.SDiff:input_type -> stricache.SetKeys
+	61,  // 93: stricache.StricacheService.SUnionStore:input_type -> stricache.SetStore
+	61,  // 94: stricache.StricacheService.SInterStore:input_type -> stricache.SetStore
+	61,  // 95: stricache.StricacheService.SDiffStore:input_type -> stricache.SetStore
+	5,   // 96: stricache.StricacheService.DeleteSet:input_type -> stricache.GetKey
+	62,  // 97: stricache.StricacheService.ZAdd:input_type -> stricache.SortedSetItems
+	63,  // 98: stricache.StricacheService.ZIncrBy:input_type -> stricache.SortedSetIncr
+	57,  // 99: stricache.StricacheService.ZScore:input_type -> stricache.SetMember
+	64,  // 100: stricache.StricacheService.ZRank:input_type -> stricache.SortedSetRank
+	65,  // 101: stricache.StricacheService.ZRange:input_type -> stricache.RankRange
+	66,  // 102: stricache.StricacheService.ZRangeByScore:input_type -> stricache.ScoreRange
+	67,  // 103: stricache.StricacheService.ZRangeByLex:input_type -> stricache.LexRange
+	66,  // 104: stricache.StricacheService.ZCount:input_type -> stricache.ScoreRange
+	5,   // 105: stricache.StricacheService.ZCard:input_type -> stricache.GetKey
+	56,  // 106: stricache.StricacheService.ZRem:input_type -> stricache.SetMembers
+	68,  // 107: stricache.StricacheService.ZPopMin:input_type -> stricache.SortedSetPop
+	68,  // 108: stricache.StricacheService.ZPopMax:input_type -> stricache.SortedSetPop
+	5,   // 109: stricache.StricacheService.DeleteSortedSet:input_type -> stricache.GetKey
+	4,   // 110: stricache.StricacheService.AddBytes:input_type -> stricache.BytesItem
+	4,   // 111: stricache.StricacheService.UnshiftBytes:input_type -> stricache.BytesItem
+	5,   // 112: stricache.StricacheService.GetBytes:input_type -> stricache.GetKey
+	5,   // 113: stricache.StricacheService.DeleteBytes:input_type -> stricache.GetKey
+	9,   // 114: stricache.StricacheService.ShiftBytes:input_type -> stricache.ListPop
+	9,   // 115: stricache.StricacheService.PopBytes:input_type -> stricache.ListPop
+	37,  // 116: stricache.StricacheService.PushBytes:input_type -> stricache.BytesListItem
+	8,   // 117: stricache.StricacheService.DeleteBytesList:input_type -> stricache.ListKey
+	14,  // 118: stricache.StricacheService.BlockingShiftBytes:input_type -> stricache.BlockingPop
+	14,  // 119: stricache.StricacheService.BlockingPopBytes:input_type -> stricache.BlockingPop
+	15,  // 120: stricache.StricacheService.ListRangeBytes:input_type -> stricache.ListRange
+	16,  // 121: stricache.StricacheService.ListIndexBytes:input_type -> stricache.ListIndex
+	25,  // 122: stricache.StricacheService.ListSetBytes:input_type -> stricache.BytesListSet
+	29,  // 123: stricache.StricacheService.ListInsertBytes:input_type -> stricache.BytesListInsert
+	15,  // 124: stricache.StricacheService.ListTrimBytes:input_type -> stricache.ListRange
+	33,  // 125: stricache.StricacheService.ListRemoveBytes:input_type -> stricache.BytesListRemove
+	8,   // 126: stricache.StricacheService.ListLenBytes:input_type -> stricache.ListKey
+	38,  // 127: stricache.StricacheService.GetRange:input_type -> stricache.BytesRange
+	39,  // 128: stricache.StricacheService.SetRange:input_type -> stricache.BytesSetRange
+	4,   // 129: stricache.StricacheService.Append:input_type -> stricache.BytesItem
+	70,  // 130: stricache.StricacheService.SetDocument:input_type -> stricache.DocumentUpdate
+	5,   // 131: stricache.StricacheService.GetDocument:input_type -> stricache.GetKey
+	5,   // 132: stricache.StricacheService.DeleteDocument:input_type -> stricache.GetKey
+	71,  // 133: stricache.StricacheService.ListDocuments:input_type -> stricache.DocumentFilter
+	84,  // 134: stricache.StricacheService.RegisterTypes:input_type -> google.protobuf.FileDescriptorSet
+	73,  // 135: stricache.StricacheService.JSONSet:input_type -> stricache.JSONValue
+	74,  // 136: stricache.StricacheService.JSONGet:input_type -> stricache.JSONPaths
+	77,  // 137: stricache.StricacheService.JSONDel:input_type -> stricache.JSONPath
+	78,  // 138: stricache.StricacheService.JSONArrAppend:input_type -> stricache.JSONValues
+	79,  // 139: stricache.StricacheService.JSONNumIncrBy:input_type -> stricache.JSONNumIncr
+	1,   // 140: stricache.StricacheService.AddString:output_type -> stricache.StringItem
+	2,   // 141: stricache.StricacheService.AddInt:output_type -> stricache.IntItem
+	3,   // 142: stricache.StricacheService.AddFloat:output_type -> stricache.FloatItem
+	1,   // 143: stricache.StricacheService.UnshiftString:output_type -> stricache.StringItem
+	2,   // 144: stricache.StricacheService.UnshiftInt:output_type -> stricache.IntItem
+	3,   // 145: stricache.StricacheService.UnshiftFloat:output_type -> stricache.FloatItem
+	1,   // 146: stricache.StricacheService.GetString:output_type -> stricache.StringItem
+	2,   // 147: stricache.StricacheService.GetInt:output_type -> stricache.IntItem
+	3,   // 148: stricache.StricacheService.GetFloat:output_type -> stricache.FloatItem
+	6,   // 149: stricache.StricacheService.DeleteString:output_type -> stricache.Success
+	6,   // 150: stricache.StricacheService.DeleteInt:output_type -> stricache.Success
+	6,   // 151: stricache.StricacheService.DeleteFloat:output_type -> stricache.Success
+	10,  // 152: stricache.StricacheService.ShiftString:output_type -> stricache.StringItems
+	11,  // 153: stricache.StricacheService.ShiftInt:output_type -> stricache.IntItems
+	12,  // 154: stricache.StricacheService.ShiftFloat:output_type -> stricache.FloatItems
+	10,  // 155: stricache.StricacheService.PopString:output_type -> stricache.StringItems
+	11,  // 156: stricache.StricacheService.PopInt:output_type -> stricache.IntItems
+	12,  // 157: stricache.StricacheService.PopFloat:output_type -> stricache.FloatItems
+	6,   // 158: stricache.StricacheService.PushString:output_type -> stricache.Success
+	6,   // 159: stricache.StricacheService.PushInt:output_type -> stricache.Success
+	6,   // 160: stricache.StricacheService.PushFloat:output_type -> stricache.Success
+	6,   // 161: stricache.StricacheService.DeleteStringList:output_type -> stricache.Success
+	6,   // 162: stricache.StricacheService.DeleteIntList:output_type -> stricache.Success
+	6,   // 163: stricache.StricacheService.DeleteFloatList:output_type -> stricache.Success
+	41,  // 164: stricache.StricacheService.Lists:output_type -> stricache.ListInfos
+	45,  // 165: stricache.StricacheService.Tiers:output_type -> stricache.TierInfos
+	43,  // 166: stricache.StricacheService.Info:output_type -> stricache.ServerInfo
+	47,  // 167: stricache.StricacheService.Stats:output_type -> stricache.CacheStats
+	10,  // 168: stricache.StricacheService.BlockingShiftString:output_type -> stricache.StringItems
+	11,  // 169: stricache.StricacheService.BlockingShiftInt:output_type -> stricache.IntItems
+	12,  // 170: stricache.StricacheService.BlockingShiftFloat:output_type -> stricache.FloatItems
+	10,  // 171: stricache.StricacheService.BlockingPopString:output_type -> stricache.StringItems
+	11,  // 172: stricache.StricacheService.BlockingPopInt:output_type -> stricache.IntItems
+	12,  // 173: stricache.StricacheService.BlockingPopFloat:output_type -> stricache.FloatItems
+	18,  // 174: stricache.StricacheService.ListRangeString:output_type -> stricache.StringList
+	34,  // 175: stricache.StricacheService.ListIndexString:output_type -> stricache.StringListItem
+	6,   // 176: stricache.StricacheService.ListSetString:output_type -> stricache.Success
+	17,  // 177: stricache.StricacheService.ListInsertString:output_type -> stricache.Count
+	6,   // 178: stricache.StricacheService.ListTrimString:output_type -> stricache.Success
+	17,  // 179: stricache.StricacheService.ListRemoveString:output_type -> stricache.Count
+	17,  // 180: stricache.StricacheService.ListLenString:output_type -> stricache.Count
+	19,  // 181: stricache.StricacheService.ListRangeInt:output_type -> stricache.IntList
+	35,  // 182: stricache.StricacheService.ListIndexInt:output_type -> stricache.IntListItem
+	6,   // 183: stricache.StricacheService.ListSetInt:output_type -> stricache.Success
+	17,  // 184: stricache.StricacheService.ListInsertInt:output_type -> stricache.Count
+	6,   // 185: stricache.StricacheService.ListTrimInt:output_type -> stricache.Success
+	17,  // 186: stricache.StricacheService.ListRemoveInt:output_type -> stricache.Count
+	17,  // 187: stricache.StricacheService.ListLenInt:output_type -> stricache.Count
+	20,  // 188: stricache.StricacheService.ListRangeFloat:output_type -> stricache.FloatList
+	36,  // 189: stricache.StricacheService.ListIndexFloat:output_type -> stricache.FloatListItem
+	6,   // 190: stricache.StricacheService.ListSetFloat:output_type -> stricache.Success
+	17,  // 191: stricache.StricacheService.ListInsertFloat:output_type -> stricache.Count
+	6,   // 192: stricache.StricacheService.ListTrimFloat:output_type -> stricache.Success
+	17,  // 193: stricache.StricacheService.ListRemoveFloat:output_type -> stricache.Count
+	17,  // 194: stricache.StricacheService.ListLenFloat:output_type -> stricache.Count
+	17,  // 195: stricache.StricacheService.HSet:output_type -> stricache.Count
+	51,  // 196: stricache.StricacheService.HGet:output_type -> stricache.HashValue
+	52,  // 197: stricache.StricacheService.HMGet:output_type -> stricache.HashValues
+	17,  // 198: stricache.StricacheService.HDel:output_type -> stricache.Count
+	48,  // 199: stricache.StricacheService.HGetAll:output_type -> stricache.HashFields
+	18,  // 200: stricache.StricacheService.HKeys:output_type -> stricache.StringList
+	17,  // 201: stricache.StricacheService.HLen:output_type -> stricache.Count
+	2,   // 202: stricache.StricacheService.HIncrBy:output_type -> stricache.IntItem
+	55,  // 203: stricache.StricacheService.HScan:output_type -> stricache.HashScanPage
+	6,   // 204: stricache.StricacheService.DeleteHash:output_type -> stricache.Success
+	17,  // 205: stricache.StricacheService.SAdd:output_type -> stricache.Count
+	17,  // 206: stricache.StricacheService.SRem:output_type -> stricache.Count
+	58,  // 207: stricache.StricacheService.SIsMember:output_type -> stricache.IsMember
+	18,  // 208: stricache.StricacheService.SMembers:output_type -> stricache.StringList
+	17,  // 209: stricache.StricacheService.SCard:output_type -> stricache.Count
+	18,  // 210: stricache.StricacheService.SRandMember:output_type -> stricache.StringList
+	18,  // 211: stricache.StricacheService.SPop:output_type -> stricache.StringList
+	18,  // 212: stricache.StricacheService.SUnion:output_type -> stricache.StringList
+	18,  // 213: stricache.StricacheService.SInter:output_type -> stricache.StringList
+	18,  // 214: stricache.StricacheService.SDiff:output_type -> stricache.StringList
+	17,  // 215: stricache.StricacheService.SUnionStore:output_type -> stricache.Count
+	17,  // 216: stricache.StricacheService.SInterStore:output_type -> stricache.Count
+	17,  // 217: stricache.StricacheService.SDiffStore:output_type -> stricache.Count
+	6,   // 218: stricache.StricacheService.DeleteSet:output_type -> stricache.Success
+	17,  // 219: stricache.StricacheService.ZAdd:output_type -> stricache.Count
+	3,   // 220: stricache.StricacheService.ZIncrBy:output_type -> stricache.FloatItem
+	3,   // 221: stricache.StricacheService.ZScore:output_type -> stricache.FloatItem
+	17,  // 222: stricache.StricacheService.ZRank:output_type -> stricache.Count
+	12,  // 223: stricache.StricacheService.ZRange:output_type -> stricache.FloatItems
+	12,  // 224: stricache.StricacheService.ZRangeByScore:output_type -> stricache.FloatItems
+	12,  // 225: stricache.StricacheService.ZRangeByLex:output_type -> stricache.FloatItems
+	17,  // 226: stricache.StricacheService.ZCount:output_type -> stricache.Count
+	17,  // 227: stricache.StricacheService.ZCard:output_type -> stricache.Count
+	17,  // 228: stricache.StricacheService.ZRem:output_type -> stricache.Count
+	12,  // 229: stricache.StricacheService.ZPopMin:output_type -> stricache.FloatItems
+	12,  // 230: stricache.StricacheService.ZPopMax:output_type -> stricache.FloatItems
+	6,   // 231: stricache.StricacheService.DeleteSortedSet:output_type -> stricache.Success
+	4,   // 232: stricache.StricacheService.AddBytes:output_type -> stricache.BytesItem
+	4,   // 233: stricache.StricacheService.UnshiftBytes:output_type -> stricache.BytesItem
+	4,   // 234: stricache.StricacheService.GetBytes:output_type -> stricache.BytesItem
+	6,   // 235: stricache.StricacheService.DeleteBytes:output_type -> stricache.Success
+	13,  // 236: stricache.StricacheService.ShiftBytes:output_type -> stricache.BytesItems
+	13,  // 237: stricache.StricacheService.PopBytes:output_type -> stricache.BytesItems
+	6,   // 238: stricache.StricacheService.PushBytes:output_type -> stricache.Success
+	6,   // 239: stricache.StricacheService.DeleteBytesList:output_type -> stricache.Success
+	13,  // 240: stricache.StricacheService.BlockingShiftBytes:output_type -> stricache.BytesItems
+	13,  // 241: stricache.StricacheService.BlockingPopBytes:output_type -> stricache.BytesItems
+	21,  // 242: stricache.StricacheService.ListRangeBytes:output_type -> stricache.BytesList
+	37,  // 243: stricache.StricacheService.ListIndexBytes:output_type -> stricache.BytesListItem
+	6,   // 244: stricache.StricacheService.ListSetBytes:output_type -> stricache.Success
+	17,  // 245: stricache.StricacheService.ListInsertBytes:output_type -> stricache.Count
+	6,   // 246: stricache.StricacheService.ListTrimBytes:output_type -> stricache.Success
+	17,  // 247: stricache.StricacheService.ListRemoveBytes:output_type -> stricache.Count
+	17,  // 248: stricache.StricacheService.ListLenBytes:output_type -> stricache.Count
+	4,   // 249: stricache.StricacheService.GetRange:output_type -> stricache.BytesItem
+	17,  // 250: stricache.StricacheService.SetRange:output_type -> stricache.Count
+	17,  // 251: stricache.StricacheService.Append:output_type -> stricache.Count
+	69,  // 252: stricache.StricacheService.SetDocument:output_type -> stricache.Document
+	69,  // 253: stricache.StricacheService.GetDocument:output_type -> stricache.Document
+	6,   // 254: stricache.StricacheService.DeleteDocument:output_type -> stricache.Success
+	72,  // 255: stricache.StricacheService.ListDocuments:output_type -> stricache.Documents
+	17,  // 256: stricache.StricacheService.RegisterTypes:output_type -> stricache.Count
+	17,  // 257: stricache.StricacheService.JSONSet:output_type -> stricache.Count
+	76,  // 258: stricache.StricacheService.JSONGet:output_type -> stricache.JSONMatches
+	17,  // 259: stricache.StricacheService.JSONDel:output_type -> stricache.Count
+	80,  // 260: stricache.StricacheService.JSONArrAppend:output_type -> stricache.Counts
+	75,  // 261: stricache.StricacheService.JSONNumIncrBy:output_type -> stricache.JSONMatch
+	140, // [140:262] is the sub-list for method output_type
+	18,  // [18:140] is the sub-list for method input_type
+	18,  // [18:18] is the sub-list for extension type_name
+	18,  // [18:18] is the sub-list for extension extendee
+	0,   // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_proto_stricache_proto_init() }
+func file_proto_stricache_proto_init() {
+	if File_proto_stricache_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_stricache_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Success); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyR); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringItems); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntItems); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatItems); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesItems); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockingPop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringListSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntListSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatListSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesListSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringListInsert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntListInsert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatListInsert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesListInsert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringListRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntListRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatListRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesListRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesSetRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TierInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TierInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashFieldNames); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashIncr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashScan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashScanPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMembers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRandom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortedSetItems); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortedSetIncr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortedSetRank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortedSetPop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Documents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONPaths); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONMatches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONNumIncr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stricache_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Counts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -552,13 +6999,14 @@ func file_proto_stricache_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_stricache_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_stricache_proto_goTypes,
 		DependencyIndexes: file_proto_stricache_proto_depIdxs,
+		EnumInfos:         file_proto_stricache_proto_enumTypes,
 		MessageInfos:      file_proto_stricache_proto_msgTypes,
 	}.Build()
 	File_proto_stricache_proto = out.File
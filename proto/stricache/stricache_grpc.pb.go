@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -34,12 +35,116 @@ type StricacheServiceClient interface {
 	DeleteString(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
 	DeleteInt(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
 	DeleteFloat(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
-	ShiftString(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error)
-	ShiftInt(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error)
-	ShiftFloat(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error)
-	PopString(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error)
-	PopInt(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error)
-	PopFloat(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error)
+	ShiftString(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*StringItems, error)
+	ShiftInt(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*IntItems, error)
+	ShiftFloat(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*FloatItems, error)
+	PopString(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*StringItems, error)
+	PopInt(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*IntItems, error)
+	PopFloat(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*FloatItems, error)
+	PushString(ctx context.Context, in *StringListItem, opts ...grpc.CallOption) (*Success, error)
+	PushInt(ctx context.Context, in *IntListItem, opts ...grpc.CallOption) (*Success, error)
+	PushFloat(ctx context.Context, in *FloatListItem, opts ...grpc.CallOption) (*Success, error)
+	DeleteStringList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error)
+	DeleteIntList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error)
+	DeleteFloatList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error)
+	Lists(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*ListInfos, error)
+	Tiers(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*TierInfos, error)
+	Info(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*ServerInfo, error)
+	Stats(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*CacheStats, error)
+	BlockingShiftString(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*StringItems, error)
+	BlockingShiftInt(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*IntItems, error)
+	BlockingShiftFloat(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*FloatItems, error)
+	BlockingPopString(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*StringItems, error)
+	BlockingPopInt(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*IntItems, error)
+	BlockingPopFloat(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*FloatItems, error)
+	ListRangeString(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*StringList, error)
+	ListIndexString(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*StringListItem, error)
+	ListSetString(ctx context.Context, in *StringListSet, opts ...grpc.CallOption) (*Success, error)
+	ListInsertString(ctx context.Context, in *StringListInsert, opts ...grpc.CallOption) (*Count, error)
+	ListTrimString(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error)
+	ListRemoveString(ctx context.Context, in *StringListRemove, opts ...grpc.CallOption) (*Count, error)
+	ListLenString(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error)
+	ListRangeInt(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*IntList, error)
+	ListIndexInt(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*IntListItem, error)
+	ListSetInt(ctx context.Context, in *IntListSet, opts ...grpc.CallOption) (*Success, error)
+	ListInsertInt(ctx context.Context, in *IntListInsert, opts ...grpc.CallOption) (*Count, error)
+	ListTrimInt(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error)
+	ListRemoveInt(ctx context.Context, in *IntListRemove, opts ...grpc.CallOption) (*Count, error)
+	ListLenInt(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error)
+	ListRangeFloat(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*FloatList, error)
+	ListIndexFloat(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*FloatListItem, error)
+	ListSetFloat(ctx context.Context, in *FloatListSet, opts ...grpc.CallOption) (*Success, error)
+	ListInsertFloat(ctx context.Context, in *FloatListInsert, opts ...grpc.CallOption) (*Count, error)
+	ListTrimFloat(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error)
+	ListRemoveFloat(ctx context.Context, in *FloatListRemove, opts ...grpc.CallOption) (*Count, error)
+	ListLenFloat(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error)
+	HSet(ctx context.Context, in *HashFields, opts ...grpc.CallOption) (*Count, error)
+	HGet(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*HashValue, error)
+	HMGet(ctx context.Context, in *HashFieldNames, opts ...grpc.CallOption) (*HashValues, error)
+	HDel(ctx context.Context, in *HashFieldNames, opts ...grpc.CallOption) (*Count, error)
+	HGetAll(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*HashFields, error)
+	HKeys(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*StringList, error)
+	HLen(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Count, error)
+	HIncrBy(ctx context.Context, in *HashIncr, opts ...grpc.CallOption) (*IntItem, error)
+	HScan(ctx context.Context, in *HashScan, opts ...grpc.CallOption) (*HashScanPage, error)
+	DeleteHash(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
+	SAdd(ctx context.Context, in *SetMembers, opts ...grpc.CallOption) (*Count, error)
+	SRem(ctx context.Context, in *SetMembers, opts ...grpc.CallOption) (*Count, error)
+	SIsMember(ctx context.Context, in *SetMember, opts ...grpc.CallOption) (*IsMember, error)
+	SMembers(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*StringList, error)
+	SCard(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Count, error)
+	SRandMember(ctx context.Context, in *SetRandom, opts ...grpc.CallOption) (*StringList, error)
+	SPop(ctx context.Context, in *SetRandom, opts ...grpc.CallOption) (*StringList, error)
+	SUnion(ctx context.Context, in *SetKeys, opts ...grpc.CallOption) (*StringList, error)
+	SInter(ctx context.Context, in *SetKeys, opts ...grpc.CallOption) (*StringList, error)
+	SDiff(ctx context.Context, in *SetKeys, opts ...grpc.CallOption) (*StringList, error)
+	SUnionStore(ctx context.Context, in *SetStore, opts ...grpc.CallOption) (*Count, error)
+	SInterStore(ctx context.Context, in *SetStore, opts ...grpc.CallOption) (*Count, error)
+	SDiffStore(ctx context.Context, in *SetStore, opts ...grpc.CallOption) (*Count, error)
+	DeleteSet(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
+	ZAdd(ctx context.Context, in *SortedSetItems, opts ...grpc.CallOption) (*Count, error)
+	ZIncrBy(ctx context.Context, in *SortedSetIncr, opts ...grpc.CallOption) (*FloatItem, error)
+	ZScore(ctx context.Context, in *SetMember, opts ...grpc.CallOption) (*FloatItem, error)
+	ZRank(ctx context.Context, in *SortedSetRank, opts ...grpc.CallOption) (*Count, error)
+	ZRange(ctx context.Context, in *RankRange, opts ...grpc.CallOption) (*FloatItems, error)
+	ZRangeByScore(ctx context.Context, in *ScoreRange, opts ...grpc.CallOption) (*FloatItems, error)
+	ZRangeByLex(ctx context.Context, in *LexRange, opts ...grpc.CallOption) (*FloatItems, error)
+	ZCount(ctx context.Context, in *ScoreRange, opts ...grpc.CallOption) (*Count, error)
+	ZCard(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Count, error)
+	ZRem(ctx context.Context, in *SetMembers, opts ...grpc.CallOption) (*Count, error)
+	ZPopMin(ctx context.Context, in *SortedSetPop, opts ...grpc.CallOption) (*FloatItems, error)
+	ZPopMax(ctx context.Context, in *SortedSetPop, opts ...grpc.CallOption) (*FloatItems, error)
+	DeleteSortedSet(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
+	AddBytes(ctx context.Context, in *BytesItem, opts ...grpc.CallOption) (*BytesItem, error)
+	UnshiftBytes(ctx context.Context, in *BytesItem, opts ...grpc.CallOption) (*BytesItem, error)
+	GetBytes(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*BytesItem, error)
+	DeleteBytes(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
+	ShiftBytes(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*BytesItems, error)
+	PopBytes(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*BytesItems, error)
+	PushBytes(ctx context.Context, in *BytesListItem, opts ...grpc.CallOption) (*Success, error)
+	DeleteBytesList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error)
+	BlockingShiftBytes(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*BytesItems, error)
+	BlockingPopBytes(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*BytesItems, error)
+	ListRangeBytes(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*BytesList, error)
+	ListIndexBytes(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*BytesListItem, error)
+	ListSetBytes(ctx context.Context, in *BytesListSet, opts ...grpc.CallOption) (*Success, error)
+	ListInsertBytes(ctx context.Context, in *BytesListInsert, opts ...grpc.CallOption) (*Count, error)
+	ListTrimBytes(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error)
+	ListRemoveBytes(ctx context.Context, in *BytesListRemove, opts ...grpc.CallOption) (*Count, error)
+	ListLenBytes(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error)
+	GetRange(ctx context.Context, in *BytesRange, opts ...grpc.CallOption) (*BytesItem, error)
+	SetRange(ctx context.Context, in *BytesSetRange, opts ...grpc.CallOption) (*Count, error)
+	Append(ctx context.Context, in *BytesItem, opts ...grpc.CallOption) (*Count, error)
+	SetDocument(ctx context.Context, in *DocumentUpdate, opts ...grpc.CallOption) (*Document, error)
+	GetDocument(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Document, error)
+	DeleteDocument(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error)
+	ListDocuments(ctx context.Context, in *DocumentFilter, opts ...grpc.CallOption) (*Documents, error)
+	RegisterTypes(ctx context.Context, in *descriptorpb.FileDescriptorSet, opts ...grpc.CallOption) (*Count, error)
+	JSONSet(ctx context.Context, in *JSONValue, opts ...grpc.CallOption) (*Count, error)
+	JSONGet(ctx context.Context, in *JSONPaths, opts ...grpc.CallOption) (*JSONMatches, error)
+	JSONDel(ctx context.Context, in *JSONPath, opts ...grpc.CallOption) (*Count, error)
+	JSONArrAppend(ctx context.Context, in *JSONValues, opts ...grpc.CallOption) (*Counts, error)
+	JSONNumIncrBy(ctx context.Context, in *JSONNumIncr, opts ...grpc.CallOption) (*JSONMatch, error)
 }
 
 type stricacheServiceClient struct {
@@ -158,476 +263,3700 @@ func (c *stricacheServiceClient) DeleteFloat(ctx context.Context, in *GetKey, op
 	return out, nil
 }
 
-func (c *stricacheServiceClient) ShiftString(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error) {
-	out := new(Success)
+func (c *stricacheServiceClient) ShiftString(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*StringItems, error) {
+	out := new(StringItems)
 	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ShiftString", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ShiftInt(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*IntItems, error) {
+	out := new(IntItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ShiftInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ShiftFloat(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ShiftFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PopString(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*StringItems, error) {
+	out := new(StringItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PopString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PopInt(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*IntItems, error) {
+	out := new(IntItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PopInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PopFloat(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PopFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PushString(ctx context.Context, in *StringListItem, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PushString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PushInt(ctx context.Context, in *IntListItem, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PushInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PushFloat(ctx context.Context, in *FloatListItem, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PushFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteStringList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteStringList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteIntList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteIntList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteFloatList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteFloatList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) Lists(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*ListInfos, error) {
+	out := new(ListInfos)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/Lists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) Tiers(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*TierInfos, error) {
+	out := new(TierInfos)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/Tiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) Info(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*ServerInfo, error) {
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/Info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) Stats(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*CacheStats, error) {
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingShiftString(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*StringItems, error) {
+	out := new(StringItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingShiftString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingShiftInt(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*IntItems, error) {
+	out := new(IntItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingShiftInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingShiftFloat(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingShiftFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingPopString(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*StringItems, error) {
+	out := new(StringItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingPopString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingPopInt(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*IntItems, error) {
+	out := new(IntItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingPopInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingPopFloat(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingPopFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRangeString(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRangeString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListIndexString(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*StringListItem, error) {
+	out := new(StringListItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListIndexString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListSetString(ctx context.Context, in *StringListSet, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListSetString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListInsertString(ctx context.Context, in *StringListInsert, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListInsertString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListTrimString(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListTrimString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRemoveString(ctx context.Context, in *StringListRemove, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRemoveString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListLenString(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListLenString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRangeInt(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*IntList, error) {
+	out := new(IntList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRangeInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListIndexInt(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*IntListItem, error) {
+	out := new(IntListItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListIndexInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListSetInt(ctx context.Context, in *IntListSet, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListSetInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListInsertInt(ctx context.Context, in *IntListInsert, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListInsertInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListTrimInt(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListTrimInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRemoveInt(ctx context.Context, in *IntListRemove, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRemoveInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListLenInt(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListLenInt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRangeFloat(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*FloatList, error) {
+	out := new(FloatList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRangeFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListIndexFloat(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*FloatListItem, error) {
+	out := new(FloatListItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListIndexFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListSetFloat(ctx context.Context, in *FloatListSet, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListSetFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListInsertFloat(ctx context.Context, in *FloatListInsert, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListInsertFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListTrimFloat(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListTrimFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRemoveFloat(ctx context.Context, in *FloatListRemove, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRemoveFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListLenFloat(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListLenFloat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HSet(ctx context.Context, in *HashFields, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HGet(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*HashValue, error) {
+	out := new(HashValue)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HMGet(ctx context.Context, in *HashFieldNames, opts ...grpc.CallOption) (*HashValues, error) {
+	out := new(HashValues)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HMGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HDel(ctx context.Context, in *HashFieldNames, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HGetAll(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*HashFields, error) {
+	out := new(HashFields)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HGetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HKeys(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HLen(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HLen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HIncrBy(ctx context.Context, in *HashIncr, opts ...grpc.CallOption) (*IntItem, error) {
+	out := new(IntItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) HScan(ctx context.Context, in *HashScan, opts ...grpc.CallOption) (*HashScanPage, error) {
+	out := new(HashScanPage)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/HScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteHash(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SAdd(ctx context.Context, in *SetMembers, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SRem(ctx context.Context, in *SetMembers, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SRem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SIsMember(ctx context.Context, in *SetMember, opts ...grpc.CallOption) (*IsMember, error) {
+	out := new(IsMember)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SIsMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SMembers(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SCard(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SRandMember(ctx context.Context, in *SetRandom, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SRandMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SPop(ctx context.Context, in *SetRandom, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SUnion(ctx context.Context, in *SetKeys, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SUnion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SInter(ctx context.Context, in *SetKeys, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SInter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SDiff(ctx context.Context, in *SetKeys, opts ...grpc.CallOption) (*StringList, error) {
+	out := new(StringList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SUnionStore(ctx context.Context, in *SetStore, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SUnionStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SInterStore(ctx context.Context, in *SetStore, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SInterStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SDiffStore(ctx context.Context, in *SetStore, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SDiffStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteSet(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZAdd(ctx context.Context, in *SortedSetItems, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZIncrBy(ctx context.Context, in *SortedSetIncr, opts ...grpc.CallOption) (*FloatItem, error) {
+	out := new(FloatItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZScore(ctx context.Context, in *SetMember, opts ...grpc.CallOption) (*FloatItem, error) {
+	out := new(FloatItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZRank(ctx context.Context, in *SortedSetRank, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZRange(ctx context.Context, in *RankRange, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZRangeByScore(ctx context.Context, in *ScoreRange, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZRangeByScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZRangeByLex(ctx context.Context, in *LexRange, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZRangeByLex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZCount(ctx context.Context, in *ScoreRange, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZCard(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZRem(ctx context.Context, in *SetMembers, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZRem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZPopMin(ctx context.Context, in *SortedSetPop, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZPopMin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ZPopMax(ctx context.Context, in *SortedSetPop, opts ...grpc.CallOption) (*FloatItems, error) {
+	out := new(FloatItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ZPopMax", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteSortedSet(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteSortedSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) AddBytes(ctx context.Context, in *BytesItem, opts ...grpc.CallOption) (*BytesItem, error) {
+	out := new(BytesItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/AddBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) UnshiftBytes(ctx context.Context, in *BytesItem, opts ...grpc.CallOption) (*BytesItem, error) {
+	out := new(BytesItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/UnshiftBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) GetBytes(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*BytesItem, error) {
+	out := new(BytesItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/GetBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteBytes(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ShiftBytes(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*BytesItems, error) {
+	out := new(BytesItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ShiftBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PopBytes(ctx context.Context, in *ListPop, opts ...grpc.CallOption) (*BytesItems, error) {
+	out := new(BytesItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PopBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) PushBytes(ctx context.Context, in *BytesListItem, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PushBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteBytesList(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteBytesList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingShiftBytes(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*BytesItems, error) {
+	out := new(BytesItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingShiftBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) BlockingPopBytes(ctx context.Context, in *BlockingPop, opts ...grpc.CallOption) (*BytesItems, error) {
+	out := new(BytesItems)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/BlockingPopBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRangeBytes(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*BytesList, error) {
+	out := new(BytesList)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRangeBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListIndexBytes(ctx context.Context, in *ListIndex, opts ...grpc.CallOption) (*BytesListItem, error) {
+	out := new(BytesListItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListIndexBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListSetBytes(ctx context.Context, in *BytesListSet, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListSetBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListInsertBytes(ctx context.Context, in *BytesListInsert, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListInsertBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListTrimBytes(ctx context.Context, in *ListRange, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListTrimBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListRemoveBytes(ctx context.Context, in *BytesListRemove, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListRemoveBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListLenBytes(ctx context.Context, in *ListKey, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListLenBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) GetRange(ctx context.Context, in *BytesRange, opts ...grpc.CallOption) (*BytesItem, error) {
+	out := new(BytesItem)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/GetRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SetRange(ctx context.Context, in *BytesSetRange, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SetRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) Append(ctx context.Context, in *BytesItem, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/Append", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) SetDocument(ctx context.Context, in *DocumentUpdate, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/SetDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) GetDocument(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/GetDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) DeleteDocument(ctx context.Context, in *GetKey, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/DeleteDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) ListDocuments(ctx context.Context, in *DocumentFilter, opts ...grpc.CallOption) (*Documents, error) {
+	out := new(Documents)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ListDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) RegisterTypes(ctx context.Context, in *descriptorpb.FileDescriptorSet, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/RegisterTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) JSONSet(ctx context.Context, in *JSONValue, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/JSONSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) JSONGet(ctx context.Context, in *JSONPaths, opts ...grpc.CallOption) (*JSONMatches, error) {
+	out := new(JSONMatches)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/JSONGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) JSONDel(ctx context.Context, in *JSONPath, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/JSONDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) JSONArrAppend(ctx context.Context, in *JSONValues, opts ...grpc.CallOption) (*Counts, error) {
+	out := new(Counts)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/JSONArrAppend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stricacheServiceClient) JSONNumIncrBy(ctx context.Context, in *JSONNumIncr, opts ...grpc.CallOption) (*JSONMatch, error) {
+	out := new(JSONMatch)
+	err := c.cc.Invoke(ctx, "/stricache.StricacheService/JSONNumIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StricacheServiceServer is the server API for StricacheService service.
+// All implementations must embed UnimplementedStricacheServiceServer
+// for forward compatibility
+type StricacheServiceServer interface {
+	AddString(context.Context, *StringItem) (*StringItem, error)
+	AddInt(context.Context, *IntItem) (*IntItem, error)
+	AddFloat(context.Context, *FloatItem) (*FloatItem, error)
+	UnshiftString(context.Context, *StringItem) (*StringItem, error)
+	UnshiftInt(context.Context, *IntItem) (*IntItem, error)
+	UnshiftFloat(context.Context, *FloatItem) (*FloatItem, error)
+	GetString(context.Context, *GetKey) (*StringItem, error)
+	GetInt(context.Context, *GetKey) (*IntItem, error)
+	GetFloat(context.Context, *GetKey) (*FloatItem, error)
+	DeleteString(context.Context, *GetKey) (*Success, error)
+	DeleteInt(context.Context, *GetKey) (*Success, error)
+	DeleteFloat(context.Context, *GetKey) (*Success, error)
+	ShiftString(context.Context, *ListPop) (*StringItems, error)
+	ShiftInt(context.Context, *ListPop) (*IntItems, error)
+	ShiftFloat(context.Context, *ListPop) (*FloatItems, error)
+	PopString(context.Context, *ListPop) (*StringItems, error)
+	PopInt(context.Context, *ListPop) (*IntItems, error)
+	PopFloat(context.Context, *ListPop) (*FloatItems, error)
+	PushString(context.Context, *StringListItem) (*Success, error)
+	PushInt(context.Context, *IntListItem) (*Success, error)
+	PushFloat(context.Context, *FloatListItem) (*Success, error)
+	DeleteStringList(context.Context, *ListKey) (*Success, error)
+	DeleteIntList(context.Context, *ListKey) (*Success, error)
+	DeleteFloatList(context.Context, *ListKey) (*Success, error)
+	Lists(context.Context, *EmptyR) (*ListInfos, error)
+	Tiers(context.Context, *EmptyR) (*TierInfos, error)
+	Info(context.Context, *EmptyR) (*ServerInfo, error)
+	Stats(context.Context, *EmptyR) (*CacheStats, error)
+	BlockingShiftString(context.Context, *BlockingPop) (*StringItems, error)
+	BlockingShiftInt(context.Context, *BlockingPop) (*IntItems, error)
+	BlockingShiftFloat(context.Context, *BlockingPop) (*FloatItems, error)
+	BlockingPopString(context.Context, *BlockingPop) (*StringItems, error)
+	BlockingPopInt(context.Context, *BlockingPop) (*IntItems, error)
+	BlockingPopFloat(context.Context, *BlockingPop) (*FloatItems, error)
+	ListRangeString(context.Context, *ListRange) (*StringList, error)
+	ListIndexString(context.Context, *ListIndex) (*StringListItem, error)
+	ListSetString(context.Context, *StringListSet) (*Success, error)
+	ListInsertString(context.Context, *StringListInsert) (*Count, error)
+	ListTrimString(context.Context, *ListRange) (*Success, error)
+	ListRemoveString(context.Context, *StringListRemove) (*Count, error)
+	ListLenString(context.Context, *ListKey) (*Count, error)
+	ListRangeInt(context.Context, *ListRange) (*IntList, error)
+	ListIndexInt(context.Context, *ListIndex) (*IntListItem, error)
+	ListSetInt(context.Context, *IntListSet) (*Success, error)
+	ListInsertInt(context.Context, *IntListInsert) (*Count, error)
+	ListTrimInt(context.Context, *ListRange) (*Success, error)
+	ListRemoveInt(context.Context, *IntListRemove) (*Count, error)
+	ListLenInt(context.Context, *ListKey) (*Count, error)
+	ListRangeFloat(context.Context, *ListRange) (*FloatList, error)
+	ListIndexFloat(context.Context, *ListIndex) (*FloatListItem, error)
+	ListSetFloat(context.Context, *FloatListSet) (*Success, error)
+	ListInsertFloat(context.Context, *FloatListInsert) (*Count, error)
+	ListTrimFloat(context.Context, *ListRange) (*Success, error)
+	ListRemoveFloat(context.Context, *FloatListRemove) (*Count, error)
+	ListLenFloat(context.Context, *ListKey) (*Count, error)
+	HSet(context.Context, *HashFields) (*Count, error)
+	HGet(context.Context, *HashField) (*HashValue, error)
+	HMGet(context.Context, *HashFieldNames) (*HashValues, error)
+	HDel(context.Context, *HashFieldNames) (*Count, error)
+	HGetAll(context.Context, *GetKey) (*HashFields, error)
+	HKeys(context.Context, *GetKey) (*StringList, error)
+	HLen(context.Context, *GetKey) (*Count, error)
+	HIncrBy(context.Context, *HashIncr) (*IntItem, error)
+	HScan(context.Context, *HashScan) (*HashScanPage, error)
+	DeleteHash(context.Context, *GetKey) (*Success, error)
+	SAdd(context.Context, *SetMembers) (*Count, error)
+	SRem(context.Context, *SetMembers) (*Count, error)
+	SIsMember(context.Context, *SetMember) (*IsMember, error)
+	SMembers(context.Context, *GetKey) (*StringList, error)
+	SCard(context.Context, *GetKey) (*Count, error)
+	SRandMember(context.Context, *SetRandom) (*StringList, error)
+	SPop(context.Context, *SetRandom) (*StringList, error)
+	SUnion(context.Context, *SetKeys) (*StringList, error)
+	SInter(context.Context, *SetKeys) (*StringList, error)
+	SDiff(context.Context, *SetKeys) (*StringList, error)
+	SUnionStore(context.Context, *SetStore) (*Count, error)
+	SInterStore(context.Context, *SetStore) (*Count, error)
+	SDiffStore(context.Context, *SetStore) (*Count, error)
+	DeleteSet(context.Context, *GetKey) (*Success, error)
+	ZAdd(context.Context, *SortedSetItems) (*Count, error)
+	ZIncrBy(context.Context, *SortedSetIncr) (*FloatItem, error)
+	ZScore(context.Context, *SetMember) (*FloatItem, error)
+	ZRank(context.Context, *SortedSetRank) (*Count, error)
+	ZRange(context.Context, *RankRange) (*FloatItems, error)
+	ZRangeByScore(context.Context, *ScoreRange) (*FloatItems, error)
+	ZRangeByLex(context.Context, *LexRange) (*FloatItems, error)
+	ZCount(context.Context, *ScoreRange) (*Count, error)
+	ZCard(context.Context, *GetKey) (*Count, error)
+	ZRem(context.Context, *SetMembers) (*Count, error)
+	ZPopMin(context.Context, *SortedSetPop) (*FloatItems, error)
+	ZPopMax(context.Context, *SortedSetPop) (*FloatItems, error)
+	DeleteSortedSet(context.Context, *GetKey) (*Success, error)
+	AddBytes(context.Context, *BytesItem) (*BytesItem, error)
+	UnshiftBytes(context.Context, *BytesItem) (*BytesItem, error)
+	GetBytes(context.Context, *GetKey) (*BytesItem, error)
+	DeleteBytes(context.Context, *GetKey) (*Success, error)
+	ShiftBytes(context.Context, *ListPop) (*BytesItems, error)
+	PopBytes(context.Context, *ListPop) (*BytesItems, error)
+	PushBytes(context.Context, *BytesListItem) (*Success, error)
+	DeleteBytesList(context.Context, *ListKey) (*Success, error)
+	BlockingShiftBytes(context.Context, *BlockingPop) (*BytesItems, error)
+	BlockingPopBytes(context.Context, *BlockingPop) (*BytesItems, error)
+	ListRangeBytes(context.Context, *ListRange) (*BytesList, error)
+	ListIndexBytes(context.Context, *ListIndex) (*BytesListItem, error)
+	ListSetBytes(context.Context, *BytesListSet) (*Success, error)
+	ListInsertBytes(context.Context, *BytesListInsert) (*Count, error)
+	ListTrimBytes(context.Context, *ListRange) (*Success, error)
+	ListRemoveBytes(context.Context, *BytesListRemove) (*Count, error)
+	ListLenBytes(context.Context, *ListKey) (*Count, error)
+	GetRange(context.Context, *BytesRange) (*BytesItem, error)
+	SetRange(context.Context, *BytesSetRange) (*Count, error)
+	Append(context.Context, *BytesItem) (*Count, error)
+	SetDocument(context.Context, *DocumentUpdate) (*Document, error)
+	GetDocument(context.Context, *GetKey) (*Document, error)
+	DeleteDocument(context.Context, *GetKey) (*Success, error)
+	ListDocuments(context.Context, *DocumentFilter) (*Documents, error)
+	RegisterTypes(context.Context, *descriptorpb.FileDescriptorSet) (*Count, error)
+	JSONSet(context.Context, *JSONValue) (*Count, error)
+	JSONGet(context.Context, *JSONPaths) (*JSONMatches, error)
+	JSONDel(context.Context, *JSONPath) (*Count, error)
+	JSONArrAppend(context.Context, *JSONValues) (*Counts, error)
+	JSONNumIncrBy(context.Context, *JSONNumIncr) (*JSONMatch, error)
+	mustEmbedUnimplementedStricacheServiceServer()
+}
+
+// UnimplementedStricacheServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStricacheServiceServer struct {
+}
+
+func (UnimplementedStricacheServiceServer) AddString(context.Context, *StringItem) (*StringItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddString not implemented")
+}
+func (UnimplementedStricacheServiceServer) AddInt(context.Context, *IntItem) (*IntItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) AddFloat(context.Context, *FloatItem) (*FloatItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) UnshiftString(context.Context, *StringItem) (*StringItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshiftString not implemented")
+}
+func (UnimplementedStricacheServiceServer) UnshiftInt(context.Context, *IntItem) (*IntItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshiftInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) UnshiftFloat(context.Context, *FloatItem) (*FloatItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshiftFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) GetString(context.Context, *GetKey) (*StringItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetString not implemented")
+}
+func (UnimplementedStricacheServiceServer) GetInt(context.Context, *GetKey) (*IntItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) GetFloat(context.Context, *GetKey) (*FloatItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteString(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteString not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteInt(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteFloat(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ShiftString(context.Context, *ListPop) (*StringItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShiftString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ShiftInt(context.Context, *ListPop) (*IntItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShiftInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ShiftFloat(context.Context, *ListPop) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShiftFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) PopString(context.Context, *ListPop) (*StringItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PopString not implemented")
+}
+func (UnimplementedStricacheServiceServer) PopInt(context.Context, *ListPop) (*IntItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PopInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) PopFloat(context.Context, *ListPop) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PopFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) PushString(context.Context, *StringListItem) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushString not implemented")
+}
+func (UnimplementedStricacheServiceServer) PushInt(context.Context, *IntListItem) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) PushFloat(context.Context, *FloatListItem) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteStringList(context.Context, *ListKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStringList not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteIntList(context.Context, *ListKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIntList not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteFloatList(context.Context, *ListKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFloatList not implemented")
+}
+func (UnimplementedStricacheServiceServer) Lists(context.Context, *EmptyR) (*ListInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lists not implemented")
+}
+func (UnimplementedStricacheServiceServer) Tiers(context.Context, *EmptyR) (*TierInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tiers not implemented")
+}
+func (UnimplementedStricacheServiceServer) Info(context.Context, *EmptyR) (*ServerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
+}
+func (UnimplementedStricacheServiceServer) Stats(context.Context, *EmptyR) (*CacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingShiftString(context.Context, *BlockingPop) (*StringItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingShiftString not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingShiftInt(context.Context, *BlockingPop) (*IntItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingShiftInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingShiftFloat(context.Context, *BlockingPop) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingShiftFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingPopString(context.Context, *BlockingPop) (*StringItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingPopString not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingPopInt(context.Context, *BlockingPop) (*IntItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingPopInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingPopFloat(context.Context, *BlockingPop) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingPopFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRangeString(context.Context, *ListRange) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRangeString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListIndexString(context.Context, *ListIndex) (*StringListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListSetString(context.Context, *StringListSet) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSetString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListInsertString(context.Context, *StringListInsert) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInsertString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListTrimString(context.Context, *ListRange) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrimString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRemoveString(context.Context, *StringListRemove) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemoveString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListLenString(context.Context, *ListKey) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLenString not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRangeInt(context.Context, *ListRange) (*IntList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRangeInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListIndexInt(context.Context, *ListIndex) (*IntListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListSetInt(context.Context, *IntListSet) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSetInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListInsertInt(context.Context, *IntListInsert) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInsertInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListTrimInt(context.Context, *ListRange) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrimInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRemoveInt(context.Context, *IntListRemove) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemoveInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListLenInt(context.Context, *ListKey) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLenInt not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRangeFloat(context.Context, *ListRange) (*FloatList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRangeFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListIndexFloat(context.Context, *ListIndex) (*FloatListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListSetFloat(context.Context, *FloatListSet) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSetFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListInsertFloat(context.Context, *FloatListInsert) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInsertFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListTrimFloat(context.Context, *ListRange) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrimFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRemoveFloat(context.Context, *FloatListRemove) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemoveFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListLenFloat(context.Context, *ListKey) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLenFloat not implemented")
+}
+func (UnimplementedStricacheServiceServer) HSet(context.Context, *HashFields) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HSet not implemented")
+}
+func (UnimplementedStricacheServiceServer) HGet(context.Context, *HashField) (*HashValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGet not implemented")
+}
+func (UnimplementedStricacheServiceServer) HMGet(context.Context, *HashFieldNames) (*HashValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMGet not implemented")
+}
+func (UnimplementedStricacheServiceServer) HDel(context.Context, *HashFieldNames) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HDel not implemented")
+}
+func (UnimplementedStricacheServiceServer) HGetAll(context.Context, *GetKey) (*HashFields, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGetAll not implemented")
+}
+func (UnimplementedStricacheServiceServer) HKeys(context.Context, *GetKey) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HKeys not implemented")
+}
+func (UnimplementedStricacheServiceServer) HLen(context.Context, *GetKey) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HLen not implemented")
+}
+func (UnimplementedStricacheServiceServer) HIncrBy(context.Context, *HashIncr) (*IntItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HIncrBy not implemented")
+}
+func (UnimplementedStricacheServiceServer) HScan(context.Context, *HashScan) (*HashScanPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HScan not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteHash(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHash not implemented")
+}
+func (UnimplementedStricacheServiceServer) SAdd(context.Context, *SetMembers) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SAdd not implemented")
+}
+func (UnimplementedStricacheServiceServer) SRem(context.Context, *SetMembers) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRem not implemented")
+}
+func (UnimplementedStricacheServiceServer) SIsMember(context.Context, *SetMember) (*IsMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SIsMember not implemented")
+}
+func (UnimplementedStricacheServiceServer) SMembers(context.Context, *GetKey) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SMembers not implemented")
+}
+func (UnimplementedStricacheServiceServer) SCard(context.Context, *GetKey) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SCard not implemented")
+}
+func (UnimplementedStricacheServiceServer) SRandMember(context.Context, *SetRandom) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRandMember not implemented")
+}
+func (UnimplementedStricacheServiceServer) SPop(context.Context, *SetRandom) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SPop not implemented")
+}
+func (UnimplementedStricacheServiceServer) SUnion(context.Context, *SetKeys) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SUnion not implemented")
+}
+func (UnimplementedStricacheServiceServer) SInter(context.Context, *SetKeys) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SInter not implemented")
+}
+func (UnimplementedStricacheServiceServer) SDiff(context.Context, *SetKeys) (*StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SDiff not implemented")
+}
+func (UnimplementedStricacheServiceServer) SUnionStore(context.Context, *SetStore) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SUnionStore not implemented")
+}
+func (UnimplementedStricacheServiceServer) SInterStore(context.Context, *SetStore) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SInterStore not implemented")
+}
+func (UnimplementedStricacheServiceServer) SDiffStore(context.Context, *SetStore) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SDiffStore not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteSet(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSet not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZAdd(context.Context, *SortedSetItems) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZAdd not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZIncrBy(context.Context, *SortedSetIncr) (*FloatItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZIncrBy not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZScore(context.Context, *SetMember) (*FloatItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZScore not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZRank(context.Context, *SortedSetRank) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRank not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZRange(context.Context, *RankRange) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRange not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZRangeByScore(context.Context, *ScoreRange) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRangeByScore not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZRangeByLex(context.Context, *LexRange) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRangeByLex not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZCount(context.Context, *ScoreRange) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZCount not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZCard(context.Context, *GetKey) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZCard not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZRem(context.Context, *SetMembers) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRem not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZPopMin(context.Context, *SortedSetPop) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZPopMin not implemented")
+}
+func (UnimplementedStricacheServiceServer) ZPopMax(context.Context, *SortedSetPop) (*FloatItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZPopMax not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteSortedSet(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSortedSet not implemented")
+}
+func (UnimplementedStricacheServiceServer) AddBytes(context.Context, *BytesItem) (*BytesItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) UnshiftBytes(context.Context, *BytesItem) (*BytesItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshiftBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) GetBytes(context.Context, *GetKey) (*BytesItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteBytes(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ShiftBytes(context.Context, *ListPop) (*BytesItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShiftBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) PopBytes(context.Context, *ListPop) (*BytesItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PopBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) PushBytes(context.Context, *BytesListItem) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteBytesList(context.Context, *ListKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBytesList not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingShiftBytes(context.Context, *BlockingPop) (*BytesItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingShiftBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) BlockingPopBytes(context.Context, *BlockingPop) (*BytesItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockingPopBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRangeBytes(context.Context, *ListRange) (*BytesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRangeBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListIndexBytes(context.Context, *ListIndex) (*BytesListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListSetBytes(context.Context, *BytesListSet) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSetBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListInsertBytes(context.Context, *BytesListInsert) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInsertBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListTrimBytes(context.Context, *ListRange) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrimBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListRemoveBytes(context.Context, *BytesListRemove) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemoveBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListLenBytes(context.Context, *ListKey) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLenBytes not implemented")
+}
+func (UnimplementedStricacheServiceServer) GetRange(context.Context, *BytesRange) (*BytesItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRange not implemented")
+}
+func (UnimplementedStricacheServiceServer) SetRange(context.Context, *BytesSetRange) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRange not implemented")
+}
+func (UnimplementedStricacheServiceServer) Append(context.Context, *BytesItem) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
+func (UnimplementedStricacheServiceServer) SetDocument(context.Context, *DocumentUpdate) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDocument not implemented")
+}
+func (UnimplementedStricacheServiceServer) GetDocument(context.Context, *GetKey) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocument not implemented")
+}
+func (UnimplementedStricacheServiceServer) DeleteDocument(context.Context, *GetKey) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocument not implemented")
+}
+func (UnimplementedStricacheServiceServer) ListDocuments(context.Context, *DocumentFilter) (*Documents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocuments not implemented")
+}
+func (UnimplementedStricacheServiceServer) RegisterTypes(context.Context, *descriptorpb.FileDescriptorSet) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTypes not implemented")
+}
+func (UnimplementedStricacheServiceServer) JSONSet(context.Context, *JSONValue) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONSet not implemented")
+}
+func (UnimplementedStricacheServiceServer) JSONGet(context.Context, *JSONPaths) (*JSONMatches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONGet not implemented")
+}
+func (UnimplementedStricacheServiceServer) JSONDel(context.Context, *JSONPath) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONDel not implemented")
+}
+func (UnimplementedStricacheServiceServer) JSONArrAppend(context.Context, *JSONValues) (*Counts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONArrAppend not implemented")
+}
+func (UnimplementedStricacheServiceServer) JSONNumIncrBy(context.Context, *JSONNumIncr) (*JSONMatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONNumIncrBy not implemented")
+}
+func (UnimplementedStricacheServiceServer) mustEmbedUnimplementedStricacheServiceServer() {}
+
+// UnsafeStricacheServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StricacheServiceServer will
+// result in compilation errors.
+type UnsafeStricacheServiceServer interface {
+	mustEmbedUnimplementedStricacheServiceServer()
+}
+
+func RegisterStricacheServiceServer(s grpc.ServiceRegistrar, srv StricacheServiceServer) {
+	s.RegisterService(&StricacheService_ServiceDesc, srv)
+}
+
+func _StricacheService_AddString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).AddString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/AddString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).AddString(ctx, req.(*StringItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_AddInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).AddInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/AddInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).AddInt(ctx, req.(*IntItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_AddFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).AddFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/AddFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).AddFloat(ctx, req.(*FloatItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_UnshiftString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).UnshiftString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/UnshiftString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).UnshiftString(ctx, req.(*StringItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_UnshiftInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).UnshiftInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/UnshiftInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).UnshiftInt(ctx, req.(*IntItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_UnshiftFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).UnshiftFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/UnshiftFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).UnshiftFloat(ctx, req.(*FloatItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_GetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).GetString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/GetString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).GetString(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_GetInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).GetInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/GetInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).GetInt(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_GetFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).GetFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/GetFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).GetFloat(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteString(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteInt(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteFloat(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ShiftString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ShiftString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ShiftString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ShiftString(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ShiftInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ShiftInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ShiftInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ShiftInt(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ShiftFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ShiftFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ShiftFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ShiftFloat(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_PopString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PopString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PopString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PopString(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_PopInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PopInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PopInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PopInt(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_PopFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PopFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PopFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PopFloat(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_PushString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringListItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PushString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PushString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PushString(ctx, req.(*StringListItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_PushInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntListItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PushInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PushInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PushInt(ctx, req.(*IntListItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_PushFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatListItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PushFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PushFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PushFloat(ctx, req.(*FloatListItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteStringList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteStringList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteStringList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteStringList(ctx, req.(*ListKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteIntList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteIntList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteIntList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteIntList(ctx, req.(*ListKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteFloatList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteFloatList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteFloatList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteFloatList(ctx, req.(*ListKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_Lists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyR)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).Lists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/Lists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).Lists(ctx, req.(*EmptyR))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_Tiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyR)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).Tiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/Tiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).Tiers(ctx, req.(*EmptyR))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyR)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).Info(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/Info",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).Info(ctx, req.(*EmptyR))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyR)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).Stats(ctx, req.(*EmptyR))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_BlockingShiftString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingShiftString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingShiftString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingShiftString(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_BlockingShiftInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingShiftInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingShiftInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingShiftInt(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_BlockingShiftFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingShiftFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingShiftFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingShiftFloat(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_BlockingPopString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingPopString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingPopString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingPopString(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_BlockingPopInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingPopInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingPopInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingPopInt(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_BlockingPopFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingPopFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingPopFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingPopFloat(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListRangeString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListRangeString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListRangeString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListRangeString(ctx, req.(*ListRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListIndexString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListIndexString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListIndexString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListIndexString(ctx, req.(*ListIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListSetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringListSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListSetString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListSetString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListSetString(ctx, req.(*StringListSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListInsertString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringListInsert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListInsertString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListInsertString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListInsertString(ctx, req.(*StringListInsert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListTrimString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListTrimString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListTrimString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListTrimString(ctx, req.(*ListRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListRemoveString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringListRemove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListRemoveString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListRemoveString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListRemoveString(ctx, req.(*StringListRemove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListLenString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListLenString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListLenString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListLenString(ctx, req.(*ListKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListRangeInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListRangeInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListRangeInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListRangeInt(ctx, req.(*ListRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListIndexInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListIndexInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListIndexInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListIndexInt(ctx, req.(*ListIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListSetInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntListSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListSetInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListSetInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListSetInt(ctx, req.(*IntListSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListInsertInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntListInsert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListInsertInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListInsertInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListInsertInt(ctx, req.(*IntListInsert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListTrimInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListTrimInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListTrimInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListTrimInt(ctx, req.(*ListRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListRemoveInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntListRemove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListRemoveInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListRemoveInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListRemoveInt(ctx, req.(*IntListRemove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListLenInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListLenInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListLenInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListLenInt(ctx, req.(*ListKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListRangeFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListRangeFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListRangeFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListRangeFloat(ctx, req.(*ListRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListIndexFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListIndexFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListIndexFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListIndexFloat(ctx, req.(*ListIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListSetFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatListSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListSetFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListSetFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListSetFloat(ctx, req.(*FloatListSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListInsertFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatListInsert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListInsertFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListInsertFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListInsertFloat(ctx, req.(*FloatListInsert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListTrimFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListTrimFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListTrimFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListTrimFloat(ctx, req.(*ListRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListRemoveFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatListRemove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListRemoveFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListRemoveFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListRemoveFloat(ctx, req.(*FloatListRemove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ListLenFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListLenFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListLenFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListLenFloat(ctx, req.(*ListKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashFields)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HSet(ctx, req.(*HashFields))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashField)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HGet(ctx, req.(*HashField))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HMGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashFieldNames)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HMGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HMGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HMGet(ctx, req.(*HashFieldNames))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashFieldNames)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HDel(ctx, req.(*HashFieldNames))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HGetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HGetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HGetAll(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HKeys(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HLen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HLen(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashIncr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HIncrBy(ctx, req.(*HashIncr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_HScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashScan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).HScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/HScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).HScan(ctx, req.(*HashScan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteHash(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SAdd(ctx, req.(*SetMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SRem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SRem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SRem(ctx, req.(*SetMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SIsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SIsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SIsMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SIsMember(ctx, req.(*SetMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SMembers(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SCard(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SRandMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRandom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SRandMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SRandMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SRandMember(ctx, req.(*SetRandom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRandom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SPop(ctx, req.(*SetRandom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SUnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SUnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SUnion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SUnion(ctx, req.(*SetKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SInter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SInter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SInter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SInter(ctx, req.(*SetKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SDiff(ctx, req.(*SetKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SUnionStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStore)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SUnionStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SUnionStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SUnionStore(ctx, req.(*SetStore))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SInterStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStore)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SInterStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SInterStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SInterStore(ctx, req.(*SetStore))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_SDiffStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStore)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).SDiffStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/SDiffStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).SDiffStore(ctx, req.(*SetStore))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteSet(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZAdd(ctx, req.(*SortedSetItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetIncr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZIncrBy(ctx, req.(*SortedSetIncr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZScore(ctx, req.(*SetMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetRank)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZRank(ctx, req.(*SortedSetRank))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZRange(ctx, req.(*RankRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZRangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZRangeByScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZRangeByScore(ctx, req.(*ScoreRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZRangeByLex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LexRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZRangeByLex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZRangeByLex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZRangeByLex(ctx, req.(*LexRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZCount(ctx, req.(*ScoreRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZCard(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZRem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZRem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZRem(ctx, req.(*SetMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZPopMin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZPopMin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZPopMin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZPopMin(ctx, req.(*SortedSetPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_ZPopMax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ZPopMax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ZPopMax",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ZPopMax(ctx, req.(*SortedSetPop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StricacheService_DeleteSortedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteSortedSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteSortedSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteSortedSet(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *stricacheServiceClient) ShiftInt(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error) {
-	out := new(Success)
-	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ShiftInt", in, out, opts...)
-	if err != nil {
+func _StricacheService_AddBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesItem)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).AddBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/AddBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).AddBytes(ctx, req.(*BytesItem))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *stricacheServiceClient) ShiftFloat(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error) {
-	out := new(Success)
-	err := c.cc.Invoke(ctx, "/stricache.StricacheService/ShiftFloat", in, out, opts...)
-	if err != nil {
+func _StricacheService_UnshiftBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesItem)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).UnshiftBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/UnshiftBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).UnshiftBytes(ctx, req.(*BytesItem))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *stricacheServiceClient) PopString(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error) {
-	out := new(Success)
-	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PopString", in, out, opts...)
-	if err != nil {
+func _StricacheService_GetBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).GetBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/GetBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).GetBytes(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *stricacheServiceClient) PopInt(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error) {
-	out := new(Success)
-	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PopInt", in, out, opts...)
-	if err != nil {
+func _StricacheService_DeleteBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKey)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteBytes(ctx, req.(*GetKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *stricacheServiceClient) PopFloat(ctx context.Context, in *EmptyR, opts ...grpc.CallOption) (*Success, error) {
-	out := new(Success)
-	err := c.cc.Invoke(ctx, "/stricache.StricacheService/PopFloat", in, out, opts...)
-	if err != nil {
+func _StricacheService_ShiftBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ShiftBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ShiftBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ShiftBytes(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// StricacheServiceServer is the server API for StricacheService service.
-// All implementations must embed UnimplementedStricacheServiceServer
-// for forward compatibility
-type StricacheServiceServer interface {
-	AddString(context.Context, *StringItem) (*StringItem, error)
-	AddInt(context.Context, *IntItem) (*IntItem, error)
-	AddFloat(context.Context, *FloatItem) (*FloatItem, error)
-	UnshiftString(context.Context, *StringItem) (*StringItem, error)
-	UnshiftInt(context.Context, *IntItem) (*IntItem, error)
-	UnshiftFloat(context.Context, *FloatItem) (*FloatItem, error)
-	GetString(context.Context, *GetKey) (*StringItem, error)
-	GetInt(context.Context, *GetKey) (*IntItem, error)
-	GetFloat(context.Context, *GetKey) (*FloatItem, error)
-	DeleteString(context.Context, *GetKey) (*Success, error)
-	DeleteInt(context.Context, *GetKey) (*Success, error)
-	DeleteFloat(context.Context, *GetKey) (*Success, error)
-	ShiftString(context.Context, *EmptyR) (*Success, error)
-	ShiftInt(context.Context, *EmptyR) (*Success, error)
-	ShiftFloat(context.Context, *EmptyR) (*Success, error)
-	PopString(context.Context, *EmptyR) (*Success, error)
-	PopInt(context.Context, *EmptyR) (*Success, error)
-	PopFloat(context.Context, *EmptyR) (*Success, error)
-	// mustEmbedUnimplementedStricacheServiceServer()
+func _StricacheService_PopBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PopBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PopBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PopBytes(ctx, req.(*ListPop))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedStricacheServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedStricacheServiceServer struct {
+func _StricacheService_PushBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesListItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).PushBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/PushBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).PushBytes(ctx, req.(*BytesListItem))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedStricacheServiceServer) AddString(context.Context, *StringItem) (*StringItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddString not implemented")
-}
-func (UnimplementedStricacheServiceServer) AddInt(context.Context, *IntItem) (*IntItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddInt not implemented")
-}
-func (UnimplementedStricacheServiceServer) AddFloat(context.Context, *FloatItem) (*FloatItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFloat not implemented")
-}
-func (UnimplementedStricacheServiceServer) UnshiftString(context.Context, *StringItem) (*StringItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnshiftString not implemented")
-}
-func (UnimplementedStricacheServiceServer) UnshiftInt(context.Context, *IntItem) (*IntItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnshiftInt not implemented")
-}
-func (UnimplementedStricacheServiceServer) UnshiftFloat(context.Context, *FloatItem) (*FloatItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnshiftFloat not implemented")
-}
-func (UnimplementedStricacheServiceServer) GetString(context.Context, *GetKey) (*StringItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetString not implemented")
-}
-func (UnimplementedStricacheServiceServer) GetInt(context.Context, *GetKey) (*IntItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInt not implemented")
-}
-func (UnimplementedStricacheServiceServer) GetFloat(context.Context, *GetKey) (*FloatItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFloat not implemented")
-}
-func (UnimplementedStricacheServiceServer) DeleteString(context.Context, *GetKey) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteString not implemented")
-}
-func (UnimplementedStricacheServiceServer) DeleteInt(context.Context, *GetKey) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteInt not implemented")
-}
-func (UnimplementedStricacheServiceServer) DeleteFloat(context.Context, *GetKey) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFloat not implemented")
-}
-func (UnimplementedStricacheServiceServer) ShiftString(context.Context, *EmptyR) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShiftString not implemented")
-}
-func (UnimplementedStricacheServiceServer) ShiftInt(context.Context, *EmptyR) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShiftInt not implemented")
-}
-func (UnimplementedStricacheServiceServer) ShiftFloat(context.Context, *EmptyR) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShiftFloat not implemented")
-}
-func (UnimplementedStricacheServiceServer) PopString(context.Context, *EmptyR) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PopString not implemented")
+func _StricacheService_DeleteBytesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).DeleteBytesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/DeleteBytesList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).DeleteBytesList(ctx, req.(*ListKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStricacheServiceServer) PopInt(context.Context, *EmptyR) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PopInt not implemented")
+
+func _StricacheService_BlockingShiftBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingShiftBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingShiftBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingShiftBytes(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStricacheServiceServer) PopFloat(context.Context, *EmptyR) (*Success, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PopFloat not implemented")
+
+func _StricacheService_BlockingPopBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockingPop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).BlockingPopBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/BlockingPopBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).BlockingPopBytes(ctx, req.(*BlockingPop))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStricacheServiceServer) mustEmbedUnimplementedStricacheServiceServer() {}
 
-// UnsafeStricacheServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StricacheServiceServer will
-// result in compilation errors.
-type UnsafeStricacheServiceServer interface {
-	mustEmbedUnimplementedStricacheServiceServer()
+func _StricacheService_ListRangeBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListRangeBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListRangeBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListRangeBytes(ctx, req.(*ListRange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterStricacheServiceServer(s grpc.ServiceRegistrar, srv StricacheServiceServer) {
-	s.RegisterService(&StricacheService_ServiceDesc, srv)
+func _StricacheService_ListIndexBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StricacheServiceServer).ListIndexBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stricache.StricacheService/ListIndexBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StricacheServiceServer).ListIndexBytes(ctx, req.(*ListIndex))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_AddString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StringItem)
+func _StricacheService_ListSetBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesListSet)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).AddString(ctx, in)
+		return srv.(StricacheServiceServer).ListSetBytes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/AddString",
+		FullMethod: "/stricache.StricacheService/ListSetBytes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).AddString(ctx, req.(*StringItem))
+		return srv.(StricacheServiceServer).ListSetBytes(ctx, req.(*BytesListSet))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_AddInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntItem)
+func _StricacheService_ListInsertBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesListInsert)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).AddInt(ctx, in)
+		return srv.(StricacheServiceServer).ListInsertBytes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/AddInt",
+		FullMethod: "/stricache.StricacheService/ListInsertBytes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).AddInt(ctx, req.(*IntItem))
+		return srv.(StricacheServiceServer).ListInsertBytes(ctx, req.(*BytesListInsert))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_AddFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FloatItem)
+func _StricacheService_ListTrimBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).AddFloat(ctx, in)
+		return srv.(StricacheServiceServer).ListTrimBytes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/AddFloat",
+		FullMethod: "/stricache.StricacheService/ListTrimBytes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).AddFloat(ctx, req.(*FloatItem))
+		return srv.(StricacheServiceServer).ListTrimBytes(ctx, req.(*ListRange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_UnshiftString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StringItem)
+func _StricacheService_ListRemoveBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesListRemove)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).UnshiftString(ctx, in)
+		return srv.(StricacheServiceServer).ListRemoveBytes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/UnshiftString",
+		FullMethod: "/stricache.StricacheService/ListRemoveBytes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).UnshiftString(ctx, req.(*StringItem))
+		return srv.(StricacheServiceServer).ListRemoveBytes(ctx, req.(*BytesListRemove))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_UnshiftInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntItem)
+func _StricacheService_ListLenBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKey)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).UnshiftInt(ctx, in)
+		return srv.(StricacheServiceServer).ListLenBytes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/UnshiftInt",
+		FullMethod: "/stricache.StricacheService/ListLenBytes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).UnshiftInt(ctx, req.(*IntItem))
+		return srv.(StricacheServiceServer).ListLenBytes(ctx, req.(*ListKey))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_UnshiftFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FloatItem)
+func _StricacheService_GetRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesRange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).UnshiftFloat(ctx, in)
+		return srv.(StricacheServiceServer).GetRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/UnshiftFloat",
+		FullMethod: "/stricache.StricacheService/GetRange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).UnshiftFloat(ctx, req.(*FloatItem))
+		return srv.(StricacheServiceServer).GetRange(ctx, req.(*BytesRange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_GetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKey)
+func _StricacheService_SetRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesSetRange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).GetString(ctx, in)
+		return srv.(StricacheServiceServer).SetRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/GetString",
+		FullMethod: "/stricache.StricacheService/SetRange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).GetString(ctx, req.(*GetKey))
+		return srv.(StricacheServiceServer).SetRange(ctx, req.(*BytesSetRange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_GetInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKey)
+func _StricacheService_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BytesItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).GetInt(ctx, in)
+		return srv.(StricacheServiceServer).Append(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/GetInt",
+		FullMethod: "/stricache.StricacheService/Append",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).GetInt(ctx, req.(*GetKey))
+		return srv.(StricacheServiceServer).Append(ctx, req.(*BytesItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_GetFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKey)
+func _StricacheService_SetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentUpdate)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).GetFloat(ctx, in)
+		return srv.(StricacheServiceServer).SetDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/GetFloat",
+		FullMethod: "/stricache.StricacheService/SetDocument",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).GetFloat(ctx, req.(*GetKey))
+		return srv.(StricacheServiceServer).SetDocument(ctx, req.(*DocumentUpdate))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_DeleteString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _StricacheService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetKey)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).DeleteString(ctx, in)
+		return srv.(StricacheServiceServer).GetDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/DeleteString",
+		FullMethod: "/stricache.StricacheService/GetDocument",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).DeleteString(ctx, req.(*GetKey))
+		return srv.(StricacheServiceServer).GetDocument(ctx, req.(*GetKey))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_DeleteInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _StricacheService_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetKey)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).DeleteInt(ctx, in)
+		return srv.(StricacheServiceServer).DeleteDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/DeleteInt",
+		FullMethod: "/stricache.StricacheService/DeleteDocument",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).DeleteInt(ctx, req.(*GetKey))
+		return srv.(StricacheServiceServer).DeleteDocument(ctx, req.(*GetKey))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_DeleteFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKey)
+func _StricacheService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).DeleteFloat(ctx, in)
+		return srv.(StricacheServiceServer).ListDocuments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/DeleteFloat",
+		FullMethod: "/stricache.StricacheService/ListDocuments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).DeleteFloat(ctx, req.(*GetKey))
+		return srv.(StricacheServiceServer).ListDocuments(ctx, req.(*DocumentFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_ShiftString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyR)
+func _StricacheService_RegisterTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(descriptorpb.FileDescriptorSet)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).ShiftString(ctx, in)
+		return srv.(StricacheServiceServer).RegisterTypes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/ShiftString",
+		FullMethod: "/stricache.StricacheService/RegisterTypes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).ShiftString(ctx, req.(*EmptyR))
+		return srv.(StricacheServiceServer).RegisterTypes(ctx, req.(*descriptorpb.FileDescriptorSet))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_ShiftInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyR)
+func _StricacheService_JSONSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).ShiftInt(ctx, in)
+		return srv.(StricacheServiceServer).JSONSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/ShiftInt",
+		FullMethod: "/stricache.StricacheService/JSONSet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).ShiftInt(ctx, req.(*EmptyR))
+		return srv.(StricacheServiceServer).JSONSet(ctx, req.(*JSONValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_ShiftFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyR)
+func _StricacheService_JSONGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPaths)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).ShiftFloat(ctx, in)
+		return srv.(StricacheServiceServer).JSONGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/ShiftFloat",
+		FullMethod: "/stricache.StricacheService/JSONGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).ShiftFloat(ctx, req.(*EmptyR))
+		return srv.(StricacheServiceServer).JSONGet(ctx, req.(*JSONPaths))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_PopString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyR)
+func _StricacheService_JSONDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPath)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).PopString(ctx, in)
+		return srv.(StricacheServiceServer).JSONDel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/PopString",
+		FullMethod: "/stricache.StricacheService/JSONDel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).PopString(ctx, req.(*EmptyR))
+		return srv.(StricacheServiceServer).JSONDel(ctx, req.(*JSONPath))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_PopInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyR)
+func _StricacheService_JSONArrAppend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONValues)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).PopInt(ctx, in)
+		return srv.(StricacheServiceServer).JSONArrAppend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/PopInt",
+		FullMethod: "/stricache.StricacheService/JSONArrAppend",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).PopInt(ctx, req.(*EmptyR))
+		return srv.(StricacheServiceServer).JSONArrAppend(ctx, req.(*JSONValues))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StricacheService_PopFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyR)
+func _StricacheService_JSONNumIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONNumIncr)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StricacheServiceServer).PopFloat(ctx, in)
+		return srv.(StricacheServiceServer).JSONNumIncrBy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/stricache.StricacheService/PopFloat",
+		FullMethod: "/stricache.StricacheService/JSONNumIncrBy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StricacheServiceServer).PopFloat(ctx, req.(*EmptyR))
+		return srv.(StricacheServiceServer).JSONNumIncrBy(ctx, req.(*JSONNumIncr))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -711,6 +4040,422 @@ var StricacheService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PopFloat",
 			Handler:    _StricacheService_PopFloat_Handler,
 		},
+		{
+			MethodName: "PushString",
+			Handler:    _StricacheService_PushString_Handler,
+		},
+		{
+			MethodName: "PushInt",
+			Handler:    _StricacheService_PushInt_Handler,
+		},
+		{
+			MethodName: "PushFloat",
+			Handler:    _StricacheService_PushFloat_Handler,
+		},
+		{
+			MethodName: "DeleteStringList",
+			Handler:    _StricacheService_DeleteStringList_Handler,
+		},
+		{
+			MethodName: "DeleteIntList",
+			Handler:    _StricacheService_DeleteIntList_Handler,
+		},
+		{
+			MethodName: "DeleteFloatList",
+			Handler:    _StricacheService_DeleteFloatList_Handler,
+		},
+		{
+			MethodName: "Lists",
+			Handler:    _StricacheService_Lists_Handler,
+		},
+		{
+			MethodName: "Tiers",
+			Handler:    _StricacheService_Tiers_Handler,
+		},
+		{
+			MethodName: "Info",
+			Handler:    _StricacheService_Info_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _StricacheService_Stats_Handler,
+		},
+		{
+			MethodName: "BlockingShiftString",
+			Handler:    _StricacheService_BlockingShiftString_Handler,
+		},
+		{
+			MethodName: "BlockingShiftInt",
+			Handler:    _StricacheService_BlockingShiftInt_Handler,
+		},
+		{
+			MethodName: "BlockingShiftFloat",
+			Handler:    _StricacheService_BlockingShiftFloat_Handler,
+		},
+		{
+			MethodName: "BlockingPopString",
+			Handler:    _StricacheService_BlockingPopString_Handler,
+		},
+		{
+			MethodName: "BlockingPopInt",
+			Handler:    _StricacheService_BlockingPopInt_Handler,
+		},
+		{
+			MethodName: "BlockingPopFloat",
+			Handler:    _StricacheService_BlockingPopFloat_Handler,
+		},
+		{
+			MethodName: "ListRangeString",
+			Handler:    _StricacheService_ListRangeString_Handler,
+		},
+		{
+			MethodName: "ListIndexString",
+			Handler:    _StricacheService_ListIndexString_Handler,
+		},
+		{
+			MethodName: "ListSetString",
+			Handler:    _StricacheService_ListSetString_Handler,
+		},
+		{
+			MethodName: "ListInsertString",
+			Handler:    _StricacheService_ListInsertString_Handler,
+		},
+		{
+			MethodName: "ListTrimString",
+			Handler:    _StricacheService_ListTrimString_Handler,
+		},
+		{
+			MethodName: "ListRemoveString",
+			Handler:    _StricacheService_ListRemoveString_Handler,
+		},
+		{
+			MethodName: "ListLenString",
+			Handler:    _StricacheService_ListLenString_Handler,
+		},
+		{
+			MethodName: "ListRangeInt",
+			Handler:    _StricacheService_ListRangeInt_Handler,
+		},
+		{
+			MethodName: "ListIndexInt",
+			Handler:    _StricacheService_ListIndexInt_Handler,
+		},
+		{
+			MethodName: "ListSetInt",
+			Handler:    _StricacheService_ListSetInt_Handler,
+		},
+		{
+			MethodName: "ListInsertInt",
+			Handler:    _StricacheService_ListInsertInt_Handler,
+		},
+		{
+			MethodName: "ListTrimInt",
+			Handler:    _StricacheService_ListTrimInt_Handler,
+		},
+		{
+			MethodName: "ListRemoveInt",
+			Handler:    _StricacheService_ListRemoveInt_Handler,
+		},
+		{
+			MethodName: "ListLenInt",
+			Handler:    _StricacheService_ListLenInt_Handler,
+		},
+		{
+			MethodName: "ListRangeFloat",
+			Handler:    _StricacheService_ListRangeFloat_Handler,
+		},
+		{
+			MethodName: "ListIndexFloat",
+			Handler:    _StricacheService_ListIndexFloat_Handler,
+		},
+		{
+			MethodName: "ListSetFloat",
+			Handler:    _StricacheService_ListSetFloat_Handler,
+		},
+		{
+			MethodName: "ListInsertFloat",
+			Handler:    _StricacheService_ListInsertFloat_Handler,
+		},
+		{
+			MethodName: "ListTrimFloat",
+			Handler:    _StricacheService_ListTrimFloat_Handler,
+		},
+		{
+			MethodName: "ListRemoveFloat",
+			Handler:    _StricacheService_ListRemoveFloat_Handler,
+		},
+		{
+			MethodName: "ListLenFloat",
+			Handler:    _StricacheService_ListLenFloat_Handler,
+		},
+		{
+			MethodName: "HSet",
+			Handler:    _StricacheService_HSet_Handler,
+		},
+		{
+			MethodName: "HGet",
+			Handler:    _StricacheService_HGet_Handler,
+		},
+		{
+			MethodName: "HMGet",
+			Handler:    _StricacheService_HMGet_Handler,
+		},
+		{
+			MethodName: "HDel",
+			Handler:    _StricacheService_HDel_Handler,
+		},
+		{
+			MethodName: "HGetAll",
+			Handler:    _StricacheService_HGetAll_Handler,
+		},
+		{
+			MethodName: "HKeys",
+			Handler:    _StricacheService_HKeys_Handler,
+		},
+		{
+			MethodName: "HLen",
+			Handler:    _StricacheService_HLen_Handler,
+		},
+		{
+			MethodName: "HIncrBy",
+			Handler:    _StricacheService_HIncrBy_Handler,
+		},
+		{
+			MethodName: "HScan",
+			Handler:    _StricacheService_HScan_Handler,
+		},
+		{
+			MethodName: "DeleteHash",
+			Handler:    _StricacheService_DeleteHash_Handler,
+		},
+		{
+			MethodName: "SAdd",
+			Handler:    _StricacheService_SAdd_Handler,
+		},
+		{
+			MethodName: "SRem",
+			Handler:    _StricacheService_SRem_Handler,
+		},
+		{
+			MethodName: "SIsMember",
+			Handler:    _StricacheService_SIsMember_Handler,
+		},
+		{
+			MethodName: "SMembers",
+			Handler:    _StricacheService_SMembers_Handler,
+		},
+		{
+			MethodName: "SCard",
+			Handler:    _StricacheService_SCard_Handler,
+		},
+		{
+			MethodName: "SRandMember",
+			Handler:    _StricacheService_SRandMember_Handler,
+		},
+		{
+			MethodName: "SPop",
+			Handler:    _StricacheService_SPop_Handler,
+		},
+		{
+			MethodName: "SUnion",
+			Handler:    _StricacheService_SUnion_Handler,
+		},
+		{
+			MethodName: "SInter",
+			Handler:    _StricacheService_SInter_Handler,
+		},
+		{
+			MethodName: "SDiff",
+			Handler:    _StricacheService_SDiff_Handler,
+		},
+		{
+			MethodName: "SUnionStore",
+			Handler:    _StricacheService_SUnionStore_Handler,
+		},
+		{
+			MethodName: "SInterStore",
+			Handler:    _StricacheService_SInterStore_Handler,
+		},
+		{
+			MethodName: "SDiffStore",
+			Handler:    _StricacheService_SDiffStore_Handler,
+		},
+		{
+			MethodName: "DeleteSet",
+			Handler:    _StricacheService_DeleteSet_Handler,
+		},
+		{
+			MethodName: "ZAdd",
+			Handler:    _StricacheService_ZAdd_Handler,
+		},
+		{
+			MethodName: "ZIncrBy",
+			Handler:    _StricacheService_ZIncrBy_Handler,
+		},
+		{
+			MethodName: "ZScore",
+			Handler:    _StricacheService_ZScore_Handler,
+		},
+		{
+			MethodName: "ZRank",
+			Handler:    _StricacheService_ZRank_Handler,
+		},
+		{
+			MethodName: "ZRange",
+			Handler:    _StricacheService_ZRange_Handler,
+		},
+		{
+			MethodName: "ZRangeByScore",
+			Handler:    _StricacheService_ZRangeByScore_Handler,
+		},
+		{
+			MethodName: "ZRangeByLex",
+			Handler:    _StricacheService_ZRangeByLex_Handler,
+		},
+		{
+			MethodName: "ZCount",
+			Handler:    _StricacheService_ZCount_Handler,
+		},
+		{
+			MethodName: "ZCard",
+			Handler:    _StricacheService_ZCard_Handler,
+		},
+		{
+			MethodName: "ZRem",
+			Handler:    _StricacheService_ZRem_Handler,
+		},
+		{
+			MethodName: "ZPopMin",
+			Handler:    _StricacheService_ZPopMin_Handler,
+		},
+		{
+			MethodName: "ZPopMax",
+			Handler:    _StricacheService_ZPopMax_Handler,
+		},
+		{
+			MethodName: "DeleteSortedSet",
+			Handler:    _StricacheService_DeleteSortedSet_Handler,
+		},
+		{
+			MethodName: "AddBytes",
+			Handler:    _StricacheService_AddBytes_Handler,
+		},
+		{
+			MethodName: "UnshiftBytes",
+			Handler:    _StricacheService_UnshiftBytes_Handler,
+		},
+		{
+			MethodName: "GetBytes",
+			Handler:    _StricacheService_GetBytes_Handler,
+		},
+		{
+			MethodName: "DeleteBytes",
+			Handler:    _StricacheService_DeleteBytes_Handler,
+		},
+		{
+			MethodName: "ShiftBytes",
+			Handler:    _StricacheService_ShiftBytes_Handler,
+		},
+		{
+			MethodName: "PopBytes",
+			Handler:    _StricacheService_PopBytes_Handler,
+		},
+		{
+			MethodName: "PushBytes",
+			Handler:    _StricacheService_PushBytes_Handler,
+		},
+		{
+			MethodName: "DeleteBytesList",
+			Handler:    _StricacheService_DeleteBytesList_Handler,
+		},
+		{
+			MethodName: "BlockingShiftBytes",
+			Handler:    _StricacheService_BlockingShiftBytes_Handler,
+		},
+		{
+			MethodName: "BlockingPopBytes",
+			Handler:    _StricacheService_BlockingPopBytes_Handler,
+		},
+		{
+			MethodName: "ListRangeBytes",
+			Handler:    _StricacheService_ListRangeBytes_Handler,
+		},
+		{
+			MethodName: "ListIndexBytes",
+			Handler:    _StricacheService_ListIndexBytes_Handler,
+		},
+		{
+			MethodName: "ListSetBytes",
+			Handler:    _StricacheService_ListSetBytes_Handler,
+		},
+		{
+			MethodName: "ListInsertBytes",
+			Handler:    _StricacheService_ListInsertBytes_Handler,
+		},
+		{
+			MethodName: "ListTrimBytes",
+			Handler:    _StricacheService_ListTrimBytes_Handler,
+		},
+		{
+			MethodName: "ListRemoveBytes",
+			Handler:    _StricacheService_ListRemoveBytes_Handler,
+		},
+		{
+			MethodName: "ListLenBytes",
+			Handler:    _StricacheService_ListLenBytes_Handler,
+		},
+		{
+			MethodName: "GetRange",
+			Handler:    _StricacheService_GetRange_Handler,
+		},
+		{
+			MethodName: "SetRange",
+			Handler:    _StricacheService_SetRange_Handler,
+		},
+		{
+			MethodName: "Append",
+			Handler:    _StricacheService_Append_Handler,
+		},
+		{
+			MethodName: "SetDocument",
+			Handler:    _StricacheService_SetDocument_Handler,
+		},
+		{
+			MethodName: "GetDocument",
+			Handler:    _StricacheService_GetDocument_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _StricacheService_DeleteDocument_Handler,
+		},
+		{
+			MethodName: "ListDocuments",
+			Handler:    _StricacheService_ListDocuments_Handler,
+		},
+		{
+			MethodName: "RegisterTypes",
+			Handler:    _StricacheService_RegisterTypes_Handler,
+		},
+		{
+			MethodName: "JSONSet",
+			Handler:    _StricacheService_JSONSet_Handler,
+		},
+		{
+			MethodName: "JSONGet",
+			Handler:    _StricacheService_JSONGet_Handler,
+		},
+		{
+			MethodName: "JSONDel",
+			Handler:    _StricacheService_JSONDel_Handler,
+		},
+		{
+			MethodName: "JSONArrAppend",
+			Handler:    _StricacheService_JSONArrAppend_Handler,
+		},
+		{
+			MethodName: "JSONNumIncrBy",
+			Handler:    _StricacheService_JSONNumIncrBy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/stricache.proto",